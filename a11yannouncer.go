@@ -0,0 +1,33 @@
+package templ
+
+import (
+	"context"
+	"io"
+)
+
+var srOnlyClass = newComponentCSSClass("srOnly",
+	`position:absolute;width:1px;height:1px;padding:0;margin:-1px;overflow:hidden;clip:rect(0,0,0,0);white-space:nowrap;border:0;`)
+
+// NewA11yAnnouncer renders a visually hidden ARIA live region with the given id. Messages
+// are announced by screen readers by calling window.templAnnounce(id, message) in the
+// browser.
+func NewA11yAnnouncer(id string) Component {
+	return ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		if err = RenderCSSItems(ctx, w, srOnlyClass); err != nil {
+			return err
+		}
+		if err = writeStrings(w, `<div id="`, EscapeString(id), `" role="status" aria-live="polite" class="sr-only `, srOnlyClass.ID, `"></div>`); err != nil {
+			return err
+		}
+		return a11yAnnouncerScript.Render(ctx, w)
+	})
+}
+
+var a11yAnnouncerScript = ComponentScript{
+	Name: `__templ_a11yAnnouncer`,
+	// The message is cleared first, so that repeating the same message is announced again.
+	Function: `window.templAnnounce=function(id,message){` +
+		`var region=document.getElementById(id);if(!region){return;}` +
+		`region.textContent="";setTimeout(function(){region.textContent=message;},100);` +
+		`};`,
+}
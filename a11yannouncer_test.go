@@ -0,0 +1,34 @@
+package templ_test
+
+import (
+	"bytes"
+	"context"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/google/go-cmp/cmp"
+)
+
+var srOnlyClassPattern = regexp.MustCompile(`srOnly_[0-9a-f]{4}`)
+
+func TestA11yAnnouncer(t *testing.T) {
+	b := new(bytes.Buffer)
+	if err := templ.NewA11yAnnouncer(`status"`).Render(context.Background(), b); err != nil {
+		t.Fatalf("failed to render: %v", err)
+	}
+	output := srOnlyClassPattern.ReplaceAllString(b.String(), "srOnly")
+	style, rest, ok := strings.Cut(output, "</style>")
+	if !ok || !strings.Contains(style, ".srOnly{position:absolute;") {
+		t.Errorf("expected the CSS to be rendered, got %q", style)
+	}
+	html, script, _ := strings.Cut(rest, "<script")
+	expected := `<div id="status&#34;" role="status" aria-live="polite" class="sr-only srOnly"></div>`
+	if diff := cmp.Diff(expected, html); diff != "" {
+		t.Error(diff)
+	}
+	if !strings.Contains(script, "window.templAnnounce") {
+		t.Errorf("expected the script to define templAnnounce, got %q", script)
+	}
+}
@@ -0,0 +1,64 @@
+package templ
+
+import (
+	"context"
+	"io"
+)
+
+// Address is a postal address.
+type Address struct {
+	Line1 string
+	Line2 string
+	City  string
+	// State, province, or region.
+	State      string
+	PostalCode string
+	// Country is an ISO 3166-1 alpha-2 country code, e.g. GB.
+	Country string
+}
+
+// NewAddressForm renders the inputs of a postal address. The name is used as a prefix
+// of the input names, e.g. shipping-postal-code.
+//
+// The inputs use the autocomplete attribute values defined in the HTML specification,
+// so that browsers can fill in the address.
+func NewAddressForm(name string, value Address) Component {
+	return ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		if _, err = io.WriteString(w, `<fieldset class="address-form">`); err != nil {
+			return err
+		}
+		fields := []struct {
+			suffix       string
+			label        string
+			autocomplete string
+			value        string
+		}{
+			{suffix: "line1", label: "Address line 1", autocomplete: "address-line1", value: value.Line1},
+			{suffix: "line2", label: "Address line 2", autocomplete: "address-line2", value: value.Line2},
+			{suffix: "city", label: "City", autocomplete: "address-level2", value: value.City},
+			{suffix: "state", label: "State / Province", autocomplete: "address-level1", value: value.State},
+			{suffix: "postal-code", label: "Postal code", autocomplete: "postal-code", value: value.PostalCode},
+		}
+		for _, f := range fields {
+			id := EscapeString(name + "-" + f.suffix)
+			if err = writeStrings(w,
+				`<label for="`, id, `">`, f.label, `</label>`,
+				`<input type="text" id="`, id, `" name="`, id, `" value="`, EscapeString(f.value), `" autocomplete="`, f.autocomplete, `">`); err != nil {
+				return err
+			}
+		}
+		country := selectField{
+			name:         name + "-country",
+			label:        "Country",
+			autocomplete: "country",
+			placeholder:  "Select a country",
+			options:      countryOptions,
+			value:        value.Country,
+		}
+		if err = country.Render(ctx, w); err != nil {
+			return err
+		}
+		_, err = io.WriteString(w, `</fieldset>`)
+		return err
+	})
+}
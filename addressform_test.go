@@ -0,0 +1,43 @@
+package templ_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/a-h/templ"
+)
+
+func TestAddressForm(t *testing.T) {
+	b := new(bytes.Buffer)
+	address := templ.Address{
+		Line1:      "10 Downing Street",
+		City:       "London",
+		PostalCode: "SW1A 2AA",
+		Country:    "GB",
+	}
+	if err := templ.NewAddressForm("shipping", address).Render(context.Background(), b); err != nil {
+		t.Fatalf("failed to render: %v", err)
+	}
+	output := b.String()
+	for _, expected := range []string{
+		`<fieldset class="address-form">`,
+		`<label for="shipping-line1">Address line 1</label><input type="text" id="shipping-line1" name="shipping-line1" value="10 Downing Street" autocomplete="address-line1">`,
+		`<input type="text" id="shipping-line2" name="shipping-line2" value="" autocomplete="address-line2">`,
+		`<input type="text" id="shipping-city" name="shipping-city" value="London" autocomplete="address-level2">`,
+		`<input type="text" id="shipping-state" name="shipping-state" value="" autocomplete="address-level1">`,
+		`<input type="text" id="shipping-postal-code" name="shipping-postal-code" value="SW1A 2AA" autocomplete="postal-code">`,
+		`<label for="shipping-country">Country</label><select id="shipping-country" name="shipping-country" autocomplete="country"><option value="">Select a country</option>`,
+		`<option value="GB" selected>United Kingdom</option>`,
+		`<option value="US">United States</option>`,
+		`</select></fieldset>`,
+	} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("expected output to contain %q", expected)
+		}
+	}
+	if count := strings.Count(output, "<option"); count != 250 {
+		t.Errorf("expected a placeholder and 249 countries, got %d options", count)
+	}
+}
@@ -0,0 +1,49 @@
+package templ
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// SafeAttr is an HTML attribute value that has been encoded, or is trusted, and can be
+// written between the quotes of an attribute without further escaping.
+type SafeAttr string
+
+// Attr encodes the value for use as an HTML attribute value.
+//
+// The characters ", ', <, >, and & are replaced with character references, and control
+// characters are replaced with numeric character references. NUL and the C1 control
+// characters, which can't be represented in HTML, are replaced with U+FFFD.
+func Attr(s string) SafeAttr {
+	var sb strings.Builder
+	sb.Grow(len(s))
+	for _, r := range s {
+		switch {
+		case r == '"':
+			sb.WriteString("&#34;")
+		case r == '\'':
+			sb.WriteString("&#39;")
+		case r == '<':
+			sb.WriteString("&lt;")
+		case r == '>':
+			sb.WriteString("&gt;")
+		case r == '&':
+			sb.WriteString("&amp;")
+		case r == 0 || (r >= 0x80 && r <= 0x9f):
+			sb.WriteRune(unicode.ReplacementChar)
+		case unicode.IsControl(r):
+			sb.WriteString("&#x")
+			sb.WriteString(strconv.FormatInt(int64(r), 16))
+			sb.WriteString(";")
+		default:
+			sb.WriteRune(r)
+		}
+	}
+	return SafeAttr(sb.String())
+}
+
+// UnsafeAttr bypasses attribute value encoding. The value must not contain user input.
+func UnsafeAttr(s string) SafeAttr {
+	return SafeAttr(s)
+}
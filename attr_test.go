@@ -0,0 +1,73 @@
+package templ_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestAttr(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected templ.SafeAttr
+	}{
+		{
+			name:     "plain text is unchanged",
+			input:    "Close dialog",
+			expected: "Close dialog",
+		},
+		{
+			name:     "unicode text is unchanged",
+			input:    "Schließen ✓",
+			expected: "Schließen ✓",
+		},
+		{
+			name:     "HTML special characters are encoded",
+			input:    `"'<>&`,
+			expected: "&#34;&#39;&lt;&gt;&amp;",
+		},
+		{
+			name:     "control characters are encoded",
+			input:    "a\tb\nc\x1bd\x7f",
+			expected: "a&#x9;b&#xa;c&#x1b;d&#x7f;",
+		},
+		{
+			name:     "NUL and C1 control characters are replaced",
+			input:    "a\x00b\u0085c",
+			expected: "a�b�c",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if diff := cmp.Diff(tt.expected, templ.Attr(tt.input)); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestUnsafeAttr(t *testing.T) {
+	if actual := templ.UnsafeAttr("&amp;"); actual != "&amp;" {
+		t.Errorf("expected the value to be unchanged, got %q", actual)
+	}
+}
+
+func TestRenderAttributesSafeAttr(t *testing.T) {
+	b := new(bytes.Buffer)
+	attrs := templ.Attributes{
+		"title":      templ.Attr(`Say "hi"`),
+		"aria-label": templ.UnsafeAttr("Fish &amp; chips"),
+	}
+	if err := templ.RenderAttributes(context.Background(), b, attrs); err != nil {
+		t.Fatalf("failed to render: %v", err)
+	}
+	expected := ` aria-label="Fish &amp; chips" title="Say &#34;hi&#34;"`
+	if diff := cmp.Diff(expected, b.String()); diff != "" {
+		t.Error(diff)
+	}
+}
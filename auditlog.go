@@ -0,0 +1,81 @@
+package templ
+
+import (
+	"context"
+	"io"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// AuditEntry is an entry in an audit log.
+type AuditEntry struct {
+	Timestamp time.Time
+	Actor     string
+	Action    string
+	Resource  string
+	// Changes maps the name of each changed field to its old and new values.
+	Changes map[string][2]string
+}
+
+const auditLogTimeFormat = "2006-01-02 15:04:05 MST"
+
+// NewAuditLog renders the entries in a table. Entries with changes are followed by a row
+// that can be expanded to show the difference between the old and new value of each field.
+func NewAuditLog(entries []AuditEntry) Component {
+	return ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		if _, err = io.WriteString(w, `<table class="audit-log"><thead><tr>`+
+			`<th scope="col">Time</th><th scope="col">Actor</th><th scope="col">Action</th><th scope="col">Resource</th>`+
+			`</tr></thead><tbody>`); err != nil {
+			return err
+		}
+		for _, e := range entries {
+			if err = writeStrings(w,
+				`<tr>`,
+				`<td><time datetime="`, e.Timestamp.Format(time.RFC3339), `">`, e.Timestamp.Format(auditLogTimeFormat), `</time></td>`,
+				`<td>`, EscapeString(e.Actor), `</td>`,
+				`<td>`, EscapeString(e.Action), `</td>`,
+				`<td>`, EscapeString(e.Resource), `</td>`,
+				`</tr>`); err != nil {
+				return err
+			}
+			if len(e.Changes) == 0 {
+				continue
+			}
+			if err = renderAuditChanges(ctx, w, e.Changes); err != nil {
+				return err
+			}
+		}
+		_, err = io.WriteString(w, `</tbody></table>`)
+		return err
+	})
+}
+
+func renderAuditChanges(ctx context.Context, w io.Writer, changes map[string][2]string) (err error) {
+	fields := make([]string, 0, len(changes))
+	for f := range changes {
+		fields = append(fields, f)
+	}
+	sort.Strings(fields)
+	summary := "1 change"
+	if len(fields) != 1 {
+		summary = strconv.Itoa(len(fields)) + " changes"
+	}
+	if err = writeStrings(w,
+		`<tr class="audit-log-changes"><td colspan="4"><details><summary>`, summary, `</summary><dl>`); err != nil {
+		return err
+	}
+	for _, f := range fields {
+		if err = writeStrings(w, `<dt>`, EscapeString(f), `</dt><dd>`); err != nil {
+			return err
+		}
+		if err = NewDiffView(changes[f][0], changes[f][1], DiffUnified).Render(ctx, w); err != nil {
+			return err
+		}
+		if _, err = io.WriteString(w, `</dd>`); err != nil {
+			return err
+		}
+	}
+	_, err = io.WriteString(w, `</dl></details></td></tr>`)
+	return err
+}
@@ -0,0 +1,49 @@
+package templ_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/a-h/templ"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestAuditLog(t *testing.T) {
+	entries := []templ.AuditEntry{
+		{
+			Timestamp: time.Date(2024, time.March, 1, 12, 30, 0, 0, time.UTC),
+			Actor:     "alice",
+			Action:    "delete",
+			Resource:  "posts/1",
+		},
+		{
+			Timestamp: time.Date(2024, time.March, 2, 9, 0, 0, 0, time.UTC),
+			Actor:     "<bob>",
+			Action:    "update",
+			Resource:  "users/2",
+			Changes: map[string][2]string{
+				"role":  {"viewer", "admin"},
+				"email": {"bob@example.com", "bob@example.com"},
+			},
+		},
+	}
+	b := new(bytes.Buffer)
+	if err := templ.NewAuditLog(entries).Render(context.Background(), b); err != nil {
+		t.Fatalf("failed to render: %v", err)
+	}
+	expected := `<table class="audit-log"><thead><tr>` +
+		`<th scope="col">Time</th><th scope="col">Actor</th><th scope="col">Action</th><th scope="col">Resource</th>` +
+		`</tr></thead><tbody>` +
+		`<tr><td><time datetime="2024-03-01T12:30:00Z">2024-03-01 12:30:00 UTC</time></td><td>alice</td><td>delete</td><td>posts/1</td></tr>` +
+		`<tr><td><time datetime="2024-03-02T09:00:00Z">2024-03-02 09:00:00 UTC</time></td><td>&lt;bob&gt;</td><td>update</td><td>users/2</td></tr>` +
+		`<tr class="audit-log-changes"><td colspan="4"><details><summary>2 changes</summary><dl>` +
+		"<dt>email</dt><dd><pre class=\"diff diff-unified\"><span>bob@example.com\n</span></pre></dd>" +
+		"<dt>role</dt><dd><pre class=\"diff diff-unified\"><del>viewer\n</del><ins>admin\n</ins></pre></dd>" +
+		`</dl></details></td></tr>` +
+		`</tbody></table>`
+	if diff := cmp.Diff(expected, b.String()); diff != "" {
+		t.Error(diff)
+	}
+}
@@ -0,0 +1,133 @@
+package templ
+
+import (
+	"context"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// AutoCompleteOption configures an autocomplete component.
+type AutoCompleteOption func(*autoCompleteConfig)
+
+type autoCompleteConfig struct {
+	suggestionsURL SafeURL
+}
+
+// WithSuggestionsURL sets the endpoint used to fetch suggestions as the user types.
+// The current value is passed in the q query string parameter, and the endpoint must
+// return a JSON array of strings.
+//
+// If not set, the suggestions passed to NewAutoComplete are filtered in the browser.
+func WithSuggestionsURL(url SafeURL) AutoCompleteOption {
+	return func(c *autoCompleteConfig) {
+		c.suggestionsURL = url
+	}
+}
+
+// NewAutoComplete renders an accessible combobox text input with a listbox of suggestions.
+//
+// Suggestions containing the value (ignoring case) are visible when rendered on the
+// server. In the browser, the suggestions are updated as the user types.
+func NewAutoComplete(name, label string, suggestions []string, value string, opts ...AutoCompleteOption) Component {
+	var config autoCompleteConfig
+	for _, o := range opts {
+		o(&config)
+	}
+	return ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		id := EscapeString(name)
+		listboxID := id + "-listbox"
+		matches := matchSuggestions(suggestions, value)
+		if err = writeStrings(w,
+			`<div class="autocomplete">`,
+			`<label for="`, id, `">`, EscapeString(label), `</label>`,
+			`<input type="text" id="`, id, `" name="`, id, `" value="`, EscapeString(value),
+			`" role="combobox" aria-autocomplete="list" aria-expanded="`, strconv.FormatBool(len(matches) > 0),
+			`" aria-controls="`, listboxID, `" autocomplete="off"`); err != nil {
+			return err
+		}
+		if config.suggestionsURL != "" {
+			if err = writeStrings(w, ` data-suggestions-url="`, EscapeString(string(config.suggestionsURL)), `"`); err != nil {
+				return err
+			}
+		}
+		if err = writeStrings(w, `><ul id="`, listboxID, `" role="listbox" aria-label="`, EscapeString(label), `"`); err != nil {
+			return err
+		}
+		if len(matches) == 0 {
+			if _, err = io.WriteString(w, ` hidden`); err != nil {
+				return err
+			}
+		}
+		if _, err = io.WriteString(w, `>`); err != nil {
+			return err
+		}
+		for i, s := range suggestions {
+			if err = writeStrings(w, `<li role="option" id="`, listboxID, `-`, strconv.Itoa(i), `" aria-selected="false"`); err != nil {
+				return err
+			}
+			if !matches[i] {
+				if _, err = io.WriteString(w, ` hidden`); err != nil {
+					return err
+				}
+			}
+			if err = writeStrings(w, `>`, EscapeString(s), `</li>`); err != nil {
+				return err
+			}
+		}
+		if _, err = io.WriteString(w, `</ul></div>`); err != nil {
+			return err
+		}
+		return autoCompleteScript.Render(ctx, w)
+	})
+}
+
+// matchSuggestions returns the indices of suggestions that contain the value, ignoring case.
+// An empty value matches nothing.
+func matchSuggestions(suggestions []string, value string) (matches map[int]bool) {
+	matches = make(map[int]bool)
+	if value == "" {
+		return matches
+	}
+	value = strings.ToLower(value)
+	for i, s := range suggestions {
+		if strings.Contains(strings.ToLower(s), value) {
+			matches[i] = true
+		}
+	}
+	return matches
+}
+
+// The script uses event delegation, so comboboxes rendered after it, or added to the page
+// later, e.g. by htmx, work without being set up.
+var autoCompleteScript = ComponentScript{
+	Name: `__templ_autoComplete`,
+	Function: `(function(){` +
+		`function combobox(e){var t=e.target;return t.matches&&t.matches("input[role=combobox][aria-autocomplete]")?t:null;}` +
+		`function listbox(input){return document.getElementById(input.getAttribute("aria-controls"));}` +
+		`function options(input){return Array.prototype.filter.call(listbox(input).querySelectorAll("[role=option]"),function(o){return !o.hidden;});}` +
+		`function active(input,opts){var id=input.getAttribute("aria-activedescendant");for(var i=0;i<opts.length;i++){if(opts[i].id===id){return i;}}return -1;}` +
+		`function show(input,visible){listbox(input).hidden=!visible;input.setAttribute("aria-expanded",String(visible));if(!visible){highlight(input,-1);}}` +
+		`function highlight(input,i){var opts=options(input);opts.forEach(function(o,j){o.setAttribute("aria-selected",String(i===j));});` +
+		`if(i>=0&&opts[i]){input.setAttribute("aria-activedescendant",opts[i].id);}else{input.removeAttribute("aria-activedescendant");}}` +
+		`function select(input,o){input.value=o.textContent;show(input,false);}` +
+		`function render(input,items){var lb=listbox(input);lb.innerHTML="";items.forEach(function(s,i){var li=document.createElement("li");li.setAttribute("role","option");` +
+		`li.id=lb.id+"-"+i;li.setAttribute("aria-selected","false");li.textContent=s;lb.appendChild(li);});show(input,items.length>0);}` +
+		`function filter(input){var q=input.value.toLowerCase();var any=false;listbox(input).querySelectorAll("[role=option]").forEach(function(o){` +
+		`o.hidden=!q||o.textContent.toLowerCase().indexOf(q)<0;any=any||!o.hidden;});show(input,any);}` +
+		`document.addEventListener("input",function(e){var input=combobox(e);if(!input){return;}` +
+		`var url=input.getAttribute("data-suggestions-url");if(!url){filter(input);return;}` +
+		`var u=new URL(url,window.location.href);u.searchParams.set("q",input.value);` +
+		`fetch(u).then(function(r){return r.json();}).then(function(items){render(input,items);});});` +
+		`document.addEventListener("keydown",function(e){var input=combobox(e);if(!input||listbox(input).hidden){return;}` +
+		`var opts=options(input);if(!opts.length){return;}var i=active(input,opts);` +
+		`if(e.key==="ArrowDown"){e.preventDefault();highlight(input,(i+1)%opts.length);}` +
+		`else if(e.key==="ArrowUp"){e.preventDefault();highlight(input,(i-1+opts.length)%opts.length);}` +
+		`else if(e.key==="Enter"&&i>=0){e.preventDefault();select(input,opts[i]);}` +
+		`else if(e.key==="Escape"){show(input,false);}});` +
+		`document.addEventListener("mousedown",function(e){var o=e.target.closest&&e.target.closest("[role=listbox] > [role=option]");if(!o){return;}` +
+		`var input=document.querySelector("input[role=combobox][aria-autocomplete][aria-controls=\""+CSS.escape(o.parentNode.id)+"\"]");` +
+		`if(input){e.preventDefault();select(input,o);}});` +
+		`document.addEventListener("focusout",function(e){var input=combobox(e);if(input){show(input,false);}});` +
+		`})();`,
+}
@@ -0,0 +1,75 @@
+package templ_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestAutoComplete(t *testing.T) {
+	suggestions := []string{"Apple", "Banana", "Pineapple"}
+	tests := []struct {
+		name     string
+		input    templ.Component
+		expected string
+	}{
+		{
+			name:  "matching suggestions are visible",
+			input: templ.NewAutoComplete("fruit", "Fruit", suggestions, "app"),
+			expected: `<div class="autocomplete"><label for="fruit">Fruit</label>` +
+				`<input type="text" id="fruit" name="fruit" value="app" role="combobox" aria-autocomplete="list" aria-expanded="true" aria-controls="fruit-listbox" autocomplete="off">` +
+				`<ul id="fruit-listbox" role="listbox" aria-label="Fruit">` +
+				`<li role="option" id="fruit-listbox-0" aria-selected="false">Apple</li>` +
+				`<li role="option" id="fruit-listbox-1" aria-selected="false" hidden>Banana</li>` +
+				`<li role="option" id="fruit-listbox-2" aria-selected="false">Pineapple</li>` +
+				`</ul></div>`,
+		},
+		{
+			name:  "the listbox is hidden if there are no matches",
+			input: templ.NewAutoComplete("fruit", "Fruit", suggestions[:1], ""),
+			expected: `<div class="autocomplete"><label for="fruit">Fruit</label>` +
+				`<input type="text" id="fruit" name="fruit" value="" role="combobox" aria-autocomplete="list" aria-expanded="false" aria-controls="fruit-listbox" autocomplete="off">` +
+				`<ul id="fruit-listbox" role="listbox" aria-label="Fruit" hidden>` +
+				`<li role="option" id="fruit-listbox-0" aria-selected="false" hidden>Apple</li>` +
+				`</ul></div>`,
+		},
+		{
+			name:  "the suggestions URL can be configured",
+			input: templ.NewAutoComplete("q", "Search", nil, `<"`, templ.WithSuggestionsURL(templ.URL("/suggest?a=1&b=2"))),
+			expected: `<div class="autocomplete"><label for="q">Search</label>` +
+				`<input type="text" id="q" name="q" value="&lt;&#34;" role="combobox" aria-autocomplete="list" aria-expanded="false" aria-controls="q-listbox" autocomplete="off" data-suggestions-url="/suggest?a=1&amp;b=2">` +
+				`<ul id="q-listbox" role="listbox" aria-label="Search" hidden>` +
+				`</ul></div>`,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			b := new(bytes.Buffer)
+			if err := tt.input.Render(context.Background(), b); err != nil {
+				t.Fatalf("failed to render: %v", err)
+			}
+			actual, _, _ := strings.Cut(b.String(), "<script")
+			if diff := cmp.Diff(tt.expected, actual); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestAutoCompleteScriptUsesEventDelegation(t *testing.T) {
+	b := new(bytes.Buffer)
+	if err := templ.NewAutoComplete("q", "Search", nil, "").Render(context.Background(), b); err != nil {
+		t.Fatalf("failed to render: %v", err)
+	}
+	_, script, _ := strings.Cut(b.String(), "<script")
+	for _, event := range []string{"input", "keydown", "mousedown", "focusout"} {
+		if !strings.Contains(script, `document.addEventListener("`+event+`"`) {
+			t.Errorf("expected a document %s listener, so that comboboxes rendered later work, got %q", event, script)
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package barcode_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/a-h/templ/barcode"
+)
+
+func TestBarcode(t *testing.T) {
+	tests := []struct {
+		name           string
+		data           string
+		format         barcode.Format
+		expectedPrefix string
+		expectedError  bool
+	}{
+		{
+			name:           "Code 128",
+			data:           "ABC-123 <&>",
+			format:         barcode.Code128,
+			expectedPrefix: `<svg class="barcode" xmlns="http://www.w3.org/2000/svg" role="img" aria-label="Barcode: ABC-123 &lt;&amp;&gt;" width="`,
+		},
+		{
+			name:   "EAN-13",
+			data:   "590123412345",
+			format: barcode.EAN13,
+			// 95 modules, 50 units high.
+			expectedPrefix: `<svg class="barcode" xmlns="http://www.w3.org/2000/svg" role="img" aria-label="Barcode: 590123412345" width="190" height="100" viewBox="0 0 95 50"`,
+		},
+		{
+			name:   "QR",
+			data:   "https://example.com",
+			format: barcode.QR,
+			// Version 2, 25 modules square.
+			expectedPrefix: `<svg class="barcode" xmlns="http://www.w3.org/2000/svg" role="img" aria-label="Barcode: https://example.com" width="100" height="100" viewBox="0 0 25 25"`,
+		},
+		{
+			name:          "EAN-13 barcodes must be 12 or 13 digits",
+			data:          "123",
+			format:        barcode.EAN13,
+			expectedError: true,
+		},
+		{
+			name:          "EAN-13 barcodes must be digits",
+			data:          "ABCDEFGHIJKL",
+			format:        barcode.EAN13,
+			expectedError: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			b := new(bytes.Buffer)
+			err := barcode.New(tt.data, tt.format).Render(context.Background(), b)
+			if tt.expectedError {
+				if err == nil {
+					t.Error("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed to render: %v", err)
+			}
+			output := b.String()
+			if !strings.HasPrefix(output, tt.expectedPrefix) || !strings.HasSuffix(output, `"/></svg>`) {
+				t.Errorf("expected an SVG starting with %q, got %q", tt.expectedPrefix, output)
+			}
+			if !strings.Contains(output, `<path fill="#000" d="M`) {
+				t.Errorf("expected dark modules to be drawn, got %q", output)
+			}
+		})
+	}
+}
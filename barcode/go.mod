@@ -0,0 +1,12 @@
+module github.com/a-h/templ/barcode
+
+go 1.21
+
+require (
+	github.com/a-h/templ v0.2.663
+	github.com/boombuler/barcode v1.1.0
+)
+
+require golang.org/x/net v0.19.0 // indirect
+
+replace github.com/a-h/templ => ../
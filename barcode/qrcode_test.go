@@ -0,0 +1,63 @@
+package barcode_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/a-h/templ/barcode"
+)
+
+func TestQRCode(t *testing.T) {
+	tests := []struct {
+		name           string
+		opts           []barcode.QRCodeOption
+		expectedPrefix string
+		expectedImage  string
+	}{
+		{
+			name:           "PNG",
+			expectedPrefix: "data:image/png;base64,",
+			expectedImage:  "\x89PNG",
+		},
+		{
+			name:           "SVG",
+			opts:           []barcode.QRCodeOption{barcode.WithSVG(true)},
+			expectedPrefix: "data:image/svg+xml;base64,",
+			expectedImage:  `<svg xmlns="http://www.w3.org/2000/svg" width="128" height="128"`,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			b := new(bytes.Buffer)
+			if err := barcode.NewQRCode("https://example.com/?a=1&b=<2>", 128, tt.opts...).Render(context.Background(), b); err != nil {
+				t.Fatalf("failed to render: %v", err)
+			}
+			output := b.String()
+			src, rest, ok := strings.Cut(strings.TrimPrefix(output, `<img src="`), `"`)
+			if !ok || !strings.HasPrefix(src, tt.expectedPrefix) {
+				t.Fatalf("expected a src starting with %q, got %q", tt.expectedPrefix, output)
+			}
+			image, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(src, tt.expectedPrefix))
+			if err != nil {
+				t.Fatalf("failed to decode image: %v", err)
+			}
+			if !strings.HasPrefix(string(image), tt.expectedImage) {
+				t.Errorf("expected the image to start with %q, got %q", tt.expectedImage, image)
+			}
+			expected := ` width="128" height="128" alt="QR code for: https://example.com/?a=1&amp;b=&lt;2&gt;">`
+			if rest != expected {
+				t.Errorf("expected %q, got %q", expected, rest)
+			}
+		})
+	}
+}
+
+func TestQRCodeSizeMustBePositive(t *testing.T) {
+	if err := barcode.NewQRCode("https://example.com", 0).Render(context.Background(), new(bytes.Buffer)); err == nil {
+		t.Error("expected an error")
+	}
+}
@@ -13,6 +13,7 @@ import "fmt"
 
 func Page(count int) templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, templ_7745c5c3_W io.Writer) (templ_7745c5c3_Err error) {
+		defer func() { templ_7745c5c3_Err = templ.WrapComponentError(`Page`, templ_7745c5c3_Err) }()
 		templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templ_7745c5c3_W.(*bytes.Buffer)
 		if !templ_7745c5c3_IsBuffer {
 			templ_7745c5c3_Buffer = templ.GetBuffer()
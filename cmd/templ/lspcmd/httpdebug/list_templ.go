@@ -11,6 +11,7 @@ import "bytes"
 
 func list(uris []string) templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, templ_7745c5c3_W io.Writer) (templ_7745c5c3_Err error) {
+		defer func() { templ_7745c5c3_Err = templ.WrapComponentError(`list`, templ_7745c5c3_Err) }()
 		templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templ_7745c5c3_W.(*bytes.Buffer)
 		if !templ_7745c5c3_IsBuffer {
 			templ_7745c5c3_Buffer = templ.GetBuffer()
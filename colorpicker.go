@@ -0,0 +1,41 @@
+package templ
+
+import (
+	"context"
+	"io"
+)
+
+// colorPickerValueProperty is the CSS custom property that contains the selected color.
+const colorPickerValueProperty = "--templ-color-picker-value"
+
+var colorPickerPreviewClass = newComponentCSSClass("colorPickerPreview",
+	"display:inline-block;width:1.5em;height:1.5em;vertical-align:middle;border:1px solid currentColor;"+
+		"background-color:var("+colorPickerValueProperty+");")
+
+// NewColorPicker renders a labelled color input, with a preview swatch of the selected color.
+// The value must be a hex color, e.g. #ff0000.
+func NewColorPicker(name, label, value string) Component {
+	return ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		if err = RenderCSSItems(ctx, w, colorPickerPreviewClass); err != nil {
+			return err
+		}
+		id := EscapeString(name)
+		if err = writeStrings(w,
+			`<div class="color-picker">`,
+			`<label for="`, id, `">`, EscapeString(label), `</label>`,
+			`<input type="color" id="`, id, `" name="`, id, `" value="`, EscapeString(value), `">`,
+			`<span class="`, colorPickerPreviewClass.ID, `" style="`, EscapeString(string(SanitizeCSS(colorPickerValueProperty, value))), `" aria-hidden="true"></span>`,
+			`</div>`); err != nil {
+			return err
+		}
+		return colorPickerScript.Render(ctx, w)
+	})
+}
+
+var colorPickerScript = ComponentScript{
+	Name: `__templ_colorPicker`,
+	Function: `document.addEventListener("input",function(e){` +
+		`var input=e.target;if(!input.matches||!input.matches(".color-picker input[type=color]")){return;}` +
+		`var preview=input.nextElementSibling;if(preview){preview.style.setProperty("` + colorPickerValueProperty + `",input.value);}` +
+		`});`,
+}
@@ -0,0 +1,53 @@
+package templ_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestColorPicker(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		expected string
+	}{
+		{
+			name:  "the preview is set to the value",
+			value: "#ff0000",
+			expected: `<div class="color-picker"><label for="color">Color</label>` +
+				`<input type="color" id="color" name="color" value="#ff0000">` +
+				`<span class="colorPickerPreview_ID" style="--templ-color-picker-value:#ff0000;" aria-hidden="true"></span></div>`,
+		},
+		{
+			name:  "unsafe values are sanitized",
+			value: `red;}</style>`,
+			expected: `<div class="color-picker"><label for="color">Color</label>` +
+				`<input type="color" id="color" name="color" value="red;}&lt;/style&gt;">` +
+				`<span class="colorPickerPreview_ID" style="--templ-color-picker-value:zTemplUnsafeCSSPropertyValue;" aria-hidden="true"></span></div>`,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			b := new(bytes.Buffer)
+			if err := templ.NewColorPicker("color", "Color", tt.value).Render(context.Background(), b); err != nil {
+				t.Fatalf("failed to render: %v", err)
+			}
+			style, html, ok := strings.Cut(b.String(), "</style>")
+			if !ok || !strings.Contains(style, "background-color:var(--templ-color-picker-value)") {
+				t.Fatalf("expected the preview CSS to be rendered, got %q", b.String())
+			}
+			html, _, _ = strings.Cut(html, "<script")
+			id := style[strings.Index(style, ".")+1 : strings.Index(style, "{")]
+			html = strings.ReplaceAll(html, id, "colorPickerPreview_ID")
+			if diff := cmp.Diff(tt.expected, html); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
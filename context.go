@@ -0,0 +1,45 @@
+package templ
+
+import "context"
+
+// clone returns a copy of the rendered items, so that changes to the copy don't affect
+// the original, and vice versa. The children aren't copied, since they're immutable.
+func (v *contextValue) clone() *contextValue {
+	c := &contextValue{children: v.children}
+	if v.ss != nil {
+		c.ss = make(map[string]struct{}, len(v.ss))
+		for k := range v.ss {
+			c.ss[k] = struct{}{}
+		}
+	}
+	return c
+}
+
+// ContextFork returns a context with a copy of the scripts and CSS classes that have been
+// rendered in ctx, so that components can be rendered concurrently, e.g. in separate
+// goroutines, without sharing state. Use ContextMerge to combine the forks once rendering
+// has finished.
+func ContextFork(ctx context.Context) context.Context {
+	_, v := getContext(ctx)
+	return context.WithValue(ctx, contextKey, v.clone())
+}
+
+// ContextMerge adds the scripts and CSS classes rendered in each of the forks to base, so
+// that they're not rendered again. It must not be called until rendering with the forks
+// has finished. If base has not been initialized, it's initialized first.
+func ContextMerge(base context.Context, forks ...context.Context) context.Context {
+	base, v := getContext(base)
+	for _, fork := range forks {
+		f, ok := fork.Value(contextKey).(*contextValue)
+		if !ok || f == v {
+			continue
+		}
+		if v.ss == nil && len(f.ss) > 0 {
+			v.ss = make(map[string]struct{}, len(f.ss))
+		}
+		for k := range f.ss {
+			v.ss[k] = struct{}{}
+		}
+	}
+	return base
+}
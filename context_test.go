@@ -0,0 +1,131 @@
+package templ
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestContextValueClone(t *testing.T) {
+	t.Run("empty values can be cloned", func(t *testing.T) {
+		c := (&contextValue{}).clone()
+		if c.hasClassBeenRendered("a") {
+			t.Error("expected the clone to be empty")
+		}
+	})
+	t.Run("changes to the clone don't affect the original, and vice versa", func(t *testing.T) {
+		v := &contextValue{}
+		v.addClass("a")
+		v.addScript("b")
+		c := v.clone()
+		if !c.hasClassBeenRendered("a") || !c.hasScriptBeenRendered("b") {
+			t.Error("expected the clone to contain the rendered items")
+		}
+		c.addClass("c")
+		if v.hasClassBeenRendered("c") {
+			t.Error("expected changes to the clone not to affect the original")
+		}
+		v.addClass("d")
+		if c.hasClassBeenRendered("d") {
+			t.Error("expected changes to the original not to affect the clone")
+		}
+	})
+}
+
+func TestContextFork(t *testing.T) {
+	a := ComponentCSSClass{ID: "a", Class: ".a{}"}
+	b := ComponentCSSClass{ID: "b", Class: ".b{}"}
+	c := ComponentCSSClass{ID: "c", Class: ".c{}"}
+	render := func(ctx context.Context, classes ...any) string {
+		sb := new(strings.Builder)
+		if err := RenderCSSItems(ctx, sb, classes...); err != nil {
+			t.Fatalf("failed to render: %v", err)
+		}
+		return sb.String()
+	}
+
+	base := InitializeContext(context.Background())
+	render(base, a)
+
+	// Each fork has the items rendered before the fork, but not those rendered in other forks.
+	fork1, fork2 := ContextFork(base), ContextFork(base)
+	var wg sync.WaitGroup
+	outputs := make([]string, 2)
+	for i, fork := range []context.Context{fork1, fork2} {
+		wg.Add(1)
+		go func(i int, fork context.Context) {
+			defer wg.Done()
+			outputs[i] = render(fork, a, b)
+		}(i, fork)
+	}
+	wg.Wait()
+	for i, output := range outputs {
+		if diff := cmp.Diff(`<style type="text/css">.b{}</style>`, output); diff != "" {
+			t.Errorf("fork %d: %s", i+1, diff)
+		}
+	}
+	render(fork2, c)
+
+	// The base isn't affected until the forks are merged.
+	if diff := cmp.Diff(`<style type="text/css">.b{}</style>`, render(ContextFork(base), b)); diff != "" {
+		t.Error(diff)
+	}
+	base = ContextMerge(base, fork1, fork2)
+	if diff := cmp.Diff("", render(base, a, b, c)); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestContextMergeInitializesBase(t *testing.T) {
+	fork := ContextFork(context.Background())
+	if err := RenderCSSItems(fork, new(strings.Builder), ComponentCSSClass{ID: "a", Class: ".a{}"}); err != nil {
+		t.Fatalf("failed to render: %v", err)
+	}
+	base := ContextMerge(context.Background(), fork)
+	if _, v := getContext(base); !v.hasClassBeenRendered("a") {
+		t.Error("expected the merged context to contain the rendered class")
+	}
+}
+
+func TestInitializeContextIsIdempotent(t *testing.T) {
+	ctx := InitializeContext(context.Background())
+	v := ctx.Value(contextKey).(*contextValue)
+	v.addScript("a")
+
+	again := InitializeContext(ctx)
+	if again != ctx {
+		t.Error("expected the context to be returned unchanged")
+	}
+	if got := again.Value(contextKey).(*contextValue); got != v {
+		t.Errorf("expected the same context value, got %p, want %p", got, v)
+	}
+	if !v.hasScriptBeenRendered("a") {
+		t.Error("expected the rendered script to be retained")
+	}
+}
+
+func TestMustInitializeContext(t *testing.T) {
+	t.Run("initializes an empty context", func(t *testing.T) {
+		ctx := MustInitializeContext(context.Background())
+		if _, ok := ctx.Value(contextKey).(*contextValue); !ok {
+			t.Error("expected the context to be initialized")
+		}
+	})
+	t.Run("returns an initialized context unchanged", func(t *testing.T) {
+		ctx := InitializeContext(context.Background())
+		if got := MustInitializeContext(ctx); got != ctx {
+			t.Error("expected the context to be returned unchanged")
+		}
+	})
+	t.Run("panics if the context contains an unexpected type", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected a panic")
+			}
+		}()
+		MustInitializeContext(context.WithValue(context.Background(), contextKey, "unexpected"))
+	})
+}
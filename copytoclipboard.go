@@ -0,0 +1,32 @@
+package templ
+
+import (
+	"context"
+	"io"
+)
+
+// NewCopyToClipboard renders a button that copies the text to the clipboard. After
+// copying, the button shows "Copied!" for 2 seconds. The text is encoded into the onclick
+// handler using SafeScript.
+func NewCopyToClipboard(textToCopy string, label string) Component {
+	return ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		if err = copyToClipboardScript.Render(ctx, w); err != nil {
+			return err
+		}
+		l := EscapeString(label)
+		return writeStrings(w,
+			`<button type="button" class="copy-to-clipboard" aria-label="`, l,
+			`" onclick="`, SafeScript("__templ_copyToClipboard.bind(this)", textToCopy), `">`, l, `</button>`)
+	})
+}
+
+var copyToClipboardScript = ComponentScript{
+	Name: `__templ_copyToClipboard`,
+	Function: `function __templ_copyToClipboard(text){` +
+		`var button=this;if(!navigator.clipboard){return;}` +
+		`navigator.clipboard.writeText(text).then(function(){` +
+		`var label=button.textContent;var ariaLabel=button.getAttribute("aria-label");` +
+		`button.textContent="Copied!";button.setAttribute("aria-label","Copied!");` +
+		`setTimeout(function(){button.textContent=label;button.setAttribute("aria-label",ariaLabel);},2000);});` +
+		`}`,
+}
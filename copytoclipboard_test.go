@@ -0,0 +1,27 @@
+package templ_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCopyToClipboard(t *testing.T) {
+	b := new(bytes.Buffer)
+	if err := templ.NewCopyToClipboard(`go get "example.com/<pkg>"`, "Copy & paste").Render(context.Background(), b); err != nil {
+		t.Fatalf("failed to render: %v", err)
+	}
+	script, html, ok := strings.Cut(b.String(), "</script>")
+	if !ok || !strings.Contains(script, "navigator.clipboard.writeText") {
+		t.Errorf("expected the script to be rendered, got %q", script)
+	}
+	expected := `<button type="button" class="copy-to-clipboard" aria-label="Copy &amp; paste" ` +
+		`onclick="__templ_copyToClipboard.bind(this)(&#34;go get \&#34;example.com/\u003cpkg\u003e\&#34;&#34;)">Copy &amp; paste</button>`
+	if diff := cmp.Diff(expected, html); diff != "" {
+		t.Error(diff)
+	}
+}
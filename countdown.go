@@ -0,0 +1,54 @@
+package templ
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"time"
+)
+
+// NewCountdown renders the time remaining until the target, in days, hours, minutes and
+// seconds, which is updated every second in the browser. Once the target has passed, the
+// countdown shows that it has ended.
+func NewCountdown(target time.Time, label string) Component {
+	return ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		remaining := int(math.Ceil(time.Until(target).Seconds()))
+		datetime := target.UTC().Format(time.RFC3339)
+		if remaining <= 0 {
+			return writeStrings(w,
+				`<div class="countdown countdown-ended" data-countdown>`,
+				`<span class="countdown-label">`, EscapeString(label), `</span> `,
+				`<time datetime="`, datetime, `">Ended</time></div>`)
+		}
+		if err = writeStrings(w,
+			`<div class="countdown" data-countdown>`,
+			`<span class="countdown-label">`, EscapeString(label), `</span> `,
+			`<time datetime="`, datetime, `">`,
+			`<span class="countdown-days">`, strconv.Itoa(remaining/86400), `</span>d `,
+			`<span class="countdown-hours">`, fmt.Sprintf("%02d", remaining%86400/3600), `</span>h `,
+			`<span class="countdown-minutes">`, fmt.Sprintf("%02d", remaining%3600/60), `</span>m `,
+			`<span class="countdown-seconds">`, fmt.Sprintf("%02d", remaining%60), `</span>s`,
+			`</time></div>`); err != nil {
+			return err
+		}
+		return countdownScript.Render(ctx, w)
+	})
+}
+
+var countdownScript = ComponentScript{
+	Name: `__templ_countdown`,
+	Function: `(function(){` +
+		`function pad(n){return n<10?"0"+n:String(n);}` +
+		`function tick(){var active=false;document.querySelectorAll("[data-countdown]:not(.countdown-ended)").forEach(function(el){` +
+		`var time=el.querySelector("time");var s=Math.ceil((new Date(time.getAttribute("datetime")).getTime()-Date.now())/1000);` +
+		`if(s<=0){el.classList.add("countdown-ended");time.textContent="Ended";return;}active=true;` +
+		`el.querySelector(".countdown-days").textContent=Math.floor(s/86400);` +
+		`el.querySelector(".countdown-hours").textContent=pad(Math.floor(s%86400/3600));` +
+		`el.querySelector(".countdown-minutes").textContent=pad(Math.floor(s%3600/60));` +
+		`el.querySelector(".countdown-seconds").textContent=pad(s%60);});` +
+		`if(active){setTimeout(tick,1000);}}` +
+		`tick();` +
+		`})();`,
+}
@@ -0,0 +1,45 @@
+package templ_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/a-h/templ"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCountdown(t *testing.T) {
+	t.Run("the remaining time is rendered", func(t *testing.T) {
+		target := time.Now().Add(2*24*time.Hour + 3*time.Hour + 4*time.Minute + 30*time.Second)
+		b := new(bytes.Buffer)
+		if err := templ.NewCountdown(target, "Sale ends in").Render(context.Background(), b); err != nil {
+			t.Fatalf("failed to render: %v", err)
+		}
+		html, script, _ := strings.Cut(b.String(), "<script")
+		expected := `<div class="countdown" data-countdown><span class="countdown-label">Sale ends in</span> ` +
+			`<time datetime="` + target.UTC().Format(time.RFC3339) + `">` +
+			`<span class="countdown-days">2</span>d <span class="countdown-hours">03</span>h ` +
+			`<span class="countdown-minutes">04</span>m <span class="countdown-seconds">30</span>s</time></div>`
+		if diff := cmp.Diff(expected, html); diff != "" {
+			t.Error(diff)
+		}
+		if script == "" {
+			t.Error("expected the script to be rendered")
+		}
+	})
+	t.Run("past targets have ended", func(t *testing.T) {
+		target := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+		b := new(bytes.Buffer)
+		if err := templ.NewCountdown(target, "Auction <ended>").Render(context.Background(), b); err != nil {
+			t.Fatalf("failed to render: %v", err)
+		}
+		expected := `<div class="countdown countdown-ended" data-countdown><span class="countdown-label">Auction &lt;ended&gt;</span> ` +
+			`<time datetime="2020-01-01T00:00:00Z">Ended</time></div>`
+		if diff := cmp.Diff(expected, b.String()); diff != "" {
+			t.Error(diff)
+		}
+	})
+}
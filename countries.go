@@ -0,0 +1,254 @@
+package templ
+
+// countryOptions are the ISO 3166-1 alpha-2 country codes, ordered by name.
+var countryOptions = []SelectOption{
+	{Value: "AF", Label: "Afghanistan"},
+	{Value: "AL", Label: "Albania"},
+	{Value: "DZ", Label: "Algeria"},
+	{Value: "AS", Label: "American Samoa"},
+	{Value: "AD", Label: "Andorra"},
+	{Value: "AO", Label: "Angola"},
+	{Value: "AI", Label: "Anguilla"},
+	{Value: "AQ", Label: "Antarctica"},
+	{Value: "AG", Label: "Antigua & Barbuda"},
+	{Value: "AR", Label: "Argentina"},
+	{Value: "AM", Label: "Armenia"},
+	{Value: "AW", Label: "Aruba"},
+	{Value: "AU", Label: "Australia"},
+	{Value: "AT", Label: "Austria"},
+	{Value: "AZ", Label: "Azerbaijan"},
+	{Value: "BS", Label: "Bahamas"},
+	{Value: "BH", Label: "Bahrain"},
+	{Value: "BD", Label: "Bangladesh"},
+	{Value: "BB", Label: "Barbados"},
+	{Value: "BY", Label: "Belarus"},
+	{Value: "BE", Label: "Belgium"},
+	{Value: "BZ", Label: "Belize"},
+	{Value: "BJ", Label: "Benin"},
+	{Value: "BM", Label: "Bermuda"},
+	{Value: "BT", Label: "Bhutan"},
+	{Value: "BO", Label: "Bolivia"},
+	{Value: "BA", Label: "Bosnia & Herzegovina"},
+	{Value: "BW", Label: "Botswana"},
+	{Value: "BV", Label: "Bouvet Island"},
+	{Value: "BR", Label: "Brazil"},
+	{Value: "IO", Label: "British Indian Ocean Territory"},
+	{Value: "VG", Label: "British Virgin Islands"},
+	{Value: "BN", Label: "Brunei"},
+	{Value: "BG", Label: "Bulgaria"},
+	{Value: "BF", Label: "Burkina Faso"},
+	{Value: "BI", Label: "Burundi"},
+	{Value: "KH", Label: "Cambodia"},
+	{Value: "CM", Label: "Cameroon"},
+	{Value: "CA", Label: "Canada"},
+	{Value: "CV", Label: "Cape Verde"},
+	{Value: "BQ", Label: "Caribbean NL"},
+	{Value: "KY", Label: "Cayman Islands"},
+	{Value: "CF", Label: "Central African Rep."},
+	{Value: "TD", Label: "Chad"},
+	{Value: "CL", Label: "Chile"},
+	{Value: "CN", Label: "China"},
+	{Value: "CX", Label: "Christmas Island"},
+	{Value: "CC", Label: "Cocos (Keeling) Islands"},
+	{Value: "CO", Label: "Colombia"},
+	{Value: "KM", Label: "Comoros"},
+	{Value: "CK", Label: "Cook Islands"},
+	{Value: "CR", Label: "Costa Rica"},
+	{Value: "HR", Label: "Croatia"},
+	{Value: "CU", Label: "Cuba"},
+	{Value: "CW", Label: "Curaçao"},
+	{Value: "CY", Label: "Cyprus"},
+	{Value: "CZ", Label: "Czech Republic"},
+	{Value: "CI", Label: "Côte d'Ivoire"},
+	{Value: "CD", Label: "Democratic Republic of the Congo"},
+	{Value: "DK", Label: "Denmark"},
+	{Value: "DJ", Label: "Djibouti"},
+	{Value: "DM", Label: "Dominica"},
+	{Value: "DO", Label: "Dominican Republic"},
+	{Value: "TL", Label: "East Timor"},
+	{Value: "EC", Label: "Ecuador"},
+	{Value: "EG", Label: "Egypt"},
+	{Value: "SV", Label: "El Salvador"},
+	{Value: "GQ", Label: "Equatorial Guinea"},
+	{Value: "ER", Label: "Eritrea"},
+	{Value: "EE", Label: "Estonia"},
+	{Value: "SZ", Label: "Eswatini"},
+	{Value: "ET", Label: "Ethiopia"},
+	{Value: "FK", Label: "Falkland Islands"},
+	{Value: "FO", Label: "Faroe Islands"},
+	{Value: "FJ", Label: "Fiji"},
+	{Value: "FI", Label: "Finland"},
+	{Value: "FR", Label: "France"},
+	{Value: "GF", Label: "French Guiana"},
+	{Value: "PF", Label: "French Polynesia"},
+	{Value: "TF", Label: "French S. Terr."},
+	{Value: "GA", Label: "Gabon"},
+	{Value: "GM", Label: "Gambia"},
+	{Value: "GE", Label: "Georgia"},
+	{Value: "DE", Label: "Germany"},
+	{Value: "GH", Label: "Ghana"},
+	{Value: "GI", Label: "Gibraltar"},
+	{Value: "GR", Label: "Greece"},
+	{Value: "GL", Label: "Greenland"},
+	{Value: "GD", Label: "Grenada"},
+	{Value: "GP", Label: "Guadeloupe"},
+	{Value: "GU", Label: "Guam"},
+	{Value: "GT", Label: "Guatemala"},
+	{Value: "GG", Label: "Guernsey"},
+	{Value: "GN", Label: "Guinea"},
+	{Value: "GW", Label: "Guinea-Bissau"},
+	{Value: "GY", Label: "Guyana"},
+	{Value: "HT", Label: "Haiti"},
+	{Value: "HM", Label: "Heard Island & McDonald Islands"},
+	{Value: "HN", Label: "Honduras"},
+	{Value: "HK", Label: "Hong Kong"},
+	{Value: "HU", Label: "Hungary"},
+	{Value: "IS", Label: "Iceland"},
+	{Value: "IN", Label: "India"},
+	{Value: "ID", Label: "Indonesia"},
+	{Value: "IR", Label: "Iran"},
+	{Value: "IQ", Label: "Iraq"},
+	{Value: "IE", Label: "Ireland"},
+	{Value: "IM", Label: "Isle of Man"},
+	{Value: "IL", Label: "Israel"},
+	{Value: "IT", Label: "Italy"},
+	{Value: "JM", Label: "Jamaica"},
+	{Value: "JP", Label: "Japan"},
+	{Value: "JE", Label: "Jersey"},
+	{Value: "JO", Label: "Jordan"},
+	{Value: "KZ", Label: "Kazakhstan"},
+	{Value: "KE", Label: "Kenya"},
+	{Value: "KI", Label: "Kiribati"},
+	{Value: "KW", Label: "Kuwait"},
+	{Value: "KG", Label: "Kyrgyzstan"},
+	{Value: "LA", Label: "Laos"},
+	{Value: "LV", Label: "Latvia"},
+	{Value: "LB", Label: "Lebanon"},
+	{Value: "LS", Label: "Lesotho"},
+	{Value: "LR", Label: "Liberia"},
+	{Value: "LY", Label: "Libya"},
+	{Value: "LI", Label: "Liechtenstein"},
+	{Value: "LT", Label: "Lithuania"},
+	{Value: "LU", Label: "Luxembourg"},
+	{Value: "MO", Label: "Macau"},
+	{Value: "MG", Label: "Madagascar"},
+	{Value: "MW", Label: "Malawi"},
+	{Value: "MY", Label: "Malaysia"},
+	{Value: "MV", Label: "Maldives"},
+	{Value: "ML", Label: "Mali"},
+	{Value: "MT", Label: "Malta"},
+	{Value: "MH", Label: "Marshall Islands"},
+	{Value: "MQ", Label: "Martinique"},
+	{Value: "MR", Label: "Mauritania"},
+	{Value: "MU", Label: "Mauritius"},
+	{Value: "YT", Label: "Mayotte"},
+	{Value: "MX", Label: "Mexico"},
+	{Value: "FM", Label: "Micronesia"},
+	{Value: "MD", Label: "Moldova"},
+	{Value: "MC", Label: "Monaco"},
+	{Value: "MN", Label: "Mongolia"},
+	{Value: "ME", Label: "Montenegro"},
+	{Value: "MS", Label: "Montserrat"},
+	{Value: "MA", Label: "Morocco"},
+	{Value: "MZ", Label: "Mozambique"},
+	{Value: "MM", Label: "Myanmar"},
+	{Value: "NA", Label: "Namibia"},
+	{Value: "NR", Label: "Nauru"},
+	{Value: "NP", Label: "Nepal"},
+	{Value: "NL", Label: "Netherlands"},
+	{Value: "NC", Label: "New Caledonia"},
+	{Value: "NZ", Label: "New Zealand"},
+	{Value: "NI", Label: "Nicaragua"},
+	{Value: "NE", Label: "Niger"},
+	{Value: "NG", Label: "Nigeria"},
+	{Value: "NU", Label: "Niue"},
+	{Value: "NF", Label: "Norfolk Island"},
+	{Value: "KP", Label: "North Korea"},
+	{Value: "MK", Label: "North Macedonia"},
+	{Value: "MP", Label: "Northern Mariana Islands"},
+	{Value: "NO", Label: "Norway"},
+	{Value: "OM", Label: "Oman"},
+	{Value: "PK", Label: "Pakistan"},
+	{Value: "PW", Label: "Palau"},
+	{Value: "PS", Label: "Palestine"},
+	{Value: "PA", Label: "Panama"},
+	{Value: "PG", Label: "Papua New Guinea"},
+	{Value: "PY", Label: "Paraguay"},
+	{Value: "PE", Label: "Peru"},
+	{Value: "PH", Label: "Philippines"},
+	{Value: "PN", Label: "Pitcairn"},
+	{Value: "PL", Label: "Poland"},
+	{Value: "PT", Label: "Portugal"},
+	{Value: "PR", Label: "Puerto Rico"},
+	{Value: "QA", Label: "Qatar"},
+	{Value: "CG", Label: "Republic of the Congo"},
+	{Value: "RO", Label: "Romania"},
+	{Value: "RU", Label: "Russia"},
+	{Value: "RW", Label: "Rwanda"},
+	{Value: "RE", Label: "Réunion"},
+	{Value: "MF", Label: "Saint Martin"},
+	{Value: "WS", Label: "Samoa"},
+	{Value: "SM", Label: "San Marino"},
+	{Value: "ST", Label: "Sao Tome & Principe"},
+	{Value: "SA", Label: "Saudi Arabia"},
+	{Value: "SN", Label: "Senegal"},
+	{Value: "RS", Label: "Serbia"},
+	{Value: "SC", Label: "Seychelles"},
+	{Value: "SL", Label: "Sierra Leone"},
+	{Value: "SG", Label: "Singapore"},
+	{Value: "SX", Label: "Sint Maarten"},
+	{Value: "SK", Label: "Slovakia"},
+	{Value: "SI", Label: "Slovenia"},
+	{Value: "SB", Label: "Solomon Islands"},
+	{Value: "SO", Label: "Somalia"},
+	{Value: "ZA", Label: "South Africa"},
+	{Value: "GS", Label: "South Georgia & the South Sandwich Islands"},
+	{Value: "KR", Label: "South Korea"},
+	{Value: "SS", Label: "South Sudan"},
+	{Value: "ES", Label: "Spain"},
+	{Value: "LK", Label: "Sri Lanka"},
+	{Value: "BL", Label: "St Barthelemy"},
+	{Value: "SH", Label: "St Helena"},
+	{Value: "KN", Label: "St Kitts & Nevis"},
+	{Value: "LC", Label: "St Lucia"},
+	{Value: "PM", Label: "St Pierre & Miquelon"},
+	{Value: "VC", Label: "St Vincent"},
+	{Value: "SD", Label: "Sudan"},
+	{Value: "SR", Label: "Suriname"},
+	{Value: "SJ", Label: "Svalbard & Jan Mayen"},
+	{Value: "SE", Label: "Sweden"},
+	{Value: "CH", Label: "Switzerland"},
+	{Value: "SY", Label: "Syria"},
+	{Value: "TW", Label: "Taiwan"},
+	{Value: "TJ", Label: "Tajikistan"},
+	{Value: "TZ", Label: "Tanzania"},
+	{Value: "TH", Label: "Thailand"},
+	{Value: "TG", Label: "Togo"},
+	{Value: "TK", Label: "Tokelau"},
+	{Value: "TO", Label: "Tonga"},
+	{Value: "TT", Label: "Trinidad & Tobago"},
+	{Value: "TN", Label: "Tunisia"},
+	{Value: "TR", Label: "Turkey"},
+	{Value: "TM", Label: "Turkmenistan"},
+	{Value: "TC", Label: "Turks & Caicos Islands"},
+	{Value: "TV", Label: "Tuvalu"},
+	{Value: "VI", Label: "US Virgin Islands"},
+	{Value: "UM", Label: "US minor outlying islands"},
+	{Value: "UG", Label: "Uganda"},
+	{Value: "UA", Label: "Ukraine"},
+	{Value: "AE", Label: "United Arab Emirates"},
+	{Value: "GB", Label: "United Kingdom"},
+	{Value: "US", Label: "United States"},
+	{Value: "UY", Label: "Uruguay"},
+	{Value: "UZ", Label: "Uzbekistan"},
+	{Value: "VU", Label: "Vanuatu"},
+	{Value: "VA", Label: "Vatican City"},
+	{Value: "VE", Label: "Venezuela"},
+	{Value: "VN", Label: "Vietnam"},
+	{Value: "WF", Label: "Wallis & Futuna"},
+	{Value: "EH", Label: "Western Sahara"},
+	{Value: "YE", Label: "Yemen"},
+	{Value: "ZM", Label: "Zambia"},
+	{Value: "ZW", Label: "Zimbabwe"},
+	{Value: "AX", Label: "Åland Islands"},
+}
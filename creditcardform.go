@@ -0,0 +1,80 @@
+package templ
+
+import (
+	"context"
+	"io"
+)
+
+// CreditCardFormOption configures a credit card form.
+type CreditCardFormOption func(*creditCardFormConfig)
+
+type creditCardFormConfig struct {
+	id string
+}
+
+// WithCreditCardFormID sets the id of the form, which is also used as the prefix of the
+// ids of its inputs, e.g. id-number.
+//
+// If not set, the id is derived from the action and tokenURL, so it's only required when
+// more than one form with the same action and tokenURL is rendered on the same page.
+func WithCreditCardFormID(id string) CreditCardFormOption {
+	return func(c *creditCardFormConfig) {
+		c.id = id
+	}
+}
+
+// NewCreditCardForm renders a payment form that posts to action.
+//
+// The card inputs don't have name attributes, so card details are never submitted to
+// the server. Instead, when the form is submitted, the card details are posted as JSON
+// to the tokenURL, e.g. a payment provider's tokenization endpoint, and the token in the
+// response is submitted in the token field:
+//
+//	request:  {"number":"4242424242424242","expiry":"12/30","cvc":"123","name":"A Person"}
+//	response: {"token":"tok_123"}
+//
+// The tokenURL is sanitized with URL, so that only http and https endpoints are used.
+func NewCreditCardForm(action SafeURL, tokenURL SafeURL, opts ...CreditCardFormOption) Component {
+	config := creditCardFormConfig{
+		id: CSSIDWithLength("cc", string(action)+" "+string(tokenURL), 8),
+	}
+	for _, o := range opts {
+		o(&config)
+	}
+	return ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		id := EscapeString(config.id)
+		if err = writeStrings(w,
+			`<form id="`, id, `" class="credit-card-form" method="post" action="`, EscapeString(string(action)), `" data-credit-card-form data-token-url="`, EscapeString(string(URL(string(tokenURL)))), `">`,
+			`<label for="`, id, `-name">Name on card</label>`,
+			`<input type="text" id="`, id, `-name" autocomplete="cc-name" data-card-field="name" required>`,
+			`<label for="`, id, `-number">Card number</label>`,
+			`<input type="text" id="`, id, `-number" inputmode="numeric" autocomplete="cc-number" pattern="[0-9 ]{12,23}" data-card-field="number" required>`,
+			`<label for="`, id, `-exp">Expiry date (MM/YY)</label>`,
+			`<input type="text" id="`, id, `-exp" inputmode="numeric" autocomplete="cc-exp" placeholder="MM/YY" pattern="(0[1-9]|1[0-2])/[0-9]{2}" data-card-field="expiry" required>`,
+			`<label for="`, id, `-csc">Security code</label>`,
+			`<input type="text" id="`, id, `-csc" inputmode="numeric" autocomplete="cc-csc" pattern="[0-9]{3,4}" data-card-field="cvc" required>`,
+			`<input type="hidden" name="token" value="">`,
+			`<p role="alert" data-card-error hidden></p>`,
+			`<button type="submit">Pay</button>`,
+			`</form>`); err != nil {
+			return err
+		}
+		return creditCardFormScript.Render(ctx, w)
+	})
+}
+
+var creditCardFormScript = ComponentScript{
+	Name: `__templ_creditCardForm`,
+	Function: `document.addEventListener("submit",function(e){` +
+		`var form=e.target;if(!form.hasAttribute||!form.hasAttribute("data-credit-card-form")){return;}` +
+		`e.preventDefault();` +
+		`var card={};form.querySelectorAll("[data-card-field]").forEach(function(input){card[input.getAttribute("data-card-field")]=input.value.replace(/\s+/g,input.getAttribute("data-card-field")==="name"?" ":"");});` +
+		`var error=form.querySelector("[data-card-error]");error.hidden=true;` +
+		`fetch(form.getAttribute("data-token-url"),{method:"POST",headers:{"Content-Type":"application/json"},body:JSON.stringify(card)})` +
+		`.then(function(r){if(!r.ok){throw new Error("Card details could not be verified.");}return r.json();})` +
+		`.then(function(body){` +
+		`form.querySelectorAll("[data-card-field]").forEach(function(input){input.value="";});` +
+		`form.elements.token.value=body.token;form.submit();})` +
+		`.catch(function(err){error.textContent=err.message;error.hidden=false;});` +
+		`});`,
+}
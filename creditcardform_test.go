@@ -0,0 +1,84 @@
+package templ_test
+
+import (
+	"bytes"
+	"context"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/a-h/templ"
+)
+
+func TestCreditCardForm(t *testing.T) {
+	t.Run("card inputs are not named, so they are not submitted", func(t *testing.T) {
+		b := new(bytes.Buffer)
+		if err := templ.NewCreditCardForm("/pay", "https://tokens.example.com/v1/tokens", templ.WithCreditCardFormID("checkout")).Render(context.Background(), b); err != nil {
+			t.Fatalf("failed to render: %v", err)
+		}
+		html, _, _ := strings.Cut(b.String(), "<script")
+		if !strings.HasPrefix(html, `<form id="checkout" class="credit-card-form" method="post" action="/pay" data-credit-card-form data-token-url="https://tokens.example.com/v1/tokens">`) {
+			t.Errorf("unexpected form element: %q", html)
+		}
+		inputs := regexp.MustCompile(`<input [^>]*>`).FindAllString(html, -1)
+		if len(inputs) != 5 {
+			t.Fatalf("expected 5 inputs, got %d", len(inputs))
+		}
+		for _, input := range inputs {
+			if strings.Contains(input, "name=") != strings.Contains(input, `type="hidden"`) {
+				t.Errorf("only the token input should be named, got %q", input)
+			}
+		}
+		for _, autocomplete := range []string{"cc-name", "cc-number", "cc-exp", "cc-csc"} {
+			if !strings.Contains(html, `autocomplete="`+autocomplete+`"`) {
+				t.Errorf("expected an input with autocomplete %q", autocomplete)
+			}
+		}
+	})
+	t.Run("input ids are prefixed with the form id", func(t *testing.T) {
+		b := new(bytes.Buffer)
+		ctx := templ.InitializeContext(context.Background())
+		for _, id := range []string{"billing", "gift"} {
+			if err := templ.NewCreditCardForm("/pay", "/tokens", templ.WithCreditCardFormID(id)).Render(ctx, b); err != nil {
+				t.Fatalf("failed to render: %v", err)
+			}
+		}
+		for _, id := range []string{"billing", "gift"} {
+			for _, field := range []string{"name", "number", "exp", "csc"} {
+				label := `<label for="` + id + `-` + field + `">`
+				input := `id="` + id + `-` + field + `"`
+				if strings.Count(b.String(), label) != 1 || strings.Count(b.String(), input) != 1 {
+					t.Errorf("expected one %s label and input, got %q", id+"-"+field, b.String())
+				}
+			}
+		}
+	})
+	t.Run("forms with different actions have different ids by default", func(t *testing.T) {
+		b := new(bytes.Buffer)
+		ctx := templ.InitializeContext(context.Background())
+		for _, action := range []templ.SafeURL{"/pay", "/donate"} {
+			if err := templ.NewCreditCardForm(action, "/tokens").Render(ctx, b); err != nil {
+				t.Fatalf("failed to render: %v", err)
+			}
+		}
+		ids := regexp.MustCompile(`<form id="(cc_[0-9a-f]{8})"`).FindAllStringSubmatch(b.String(), -1)
+		if len(ids) != 2 || ids[0][1] == ids[1][1] {
+			t.Fatalf("expected two distinct form ids, got %v", ids)
+		}
+		for _, id := range ids {
+			if !strings.Contains(b.String(), `<input type="text" id="`+id[1]+`-number"`) {
+				t.Errorf("expected input ids to be prefixed with %q, got %q", id[1], b.String())
+			}
+		}
+	})
+	t.Run("the token URL is sanitized", func(t *testing.T) {
+		b := new(bytes.Buffer)
+		if err := templ.NewCreditCardForm("/pay", "javascript:alert(1)").Render(context.Background(), b); err != nil {
+			t.Fatalf("failed to render: %v", err)
+		}
+		expected := `data-token-url="` + string(templ.FailedSanitizationURL) + `"`
+		if !strings.Contains(b.String(), expected) {
+			t.Errorf("expected output to contain %q, got %q", expected, b.String())
+		}
+	})
+}
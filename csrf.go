@@ -0,0 +1,32 @@
+package templ
+
+import (
+	"context"
+	"io"
+)
+
+type csrfContextKeyType int
+
+const csrfContextKey = csrfContextKeyType(0)
+
+// WithCSRF stores a CSRF token in the context, so that components can include it in forms.
+// The token must already have been generated, or validated, by CSRF middleware.
+func WithCSRF(ctx context.Context, token string) context.Context {
+	return context.WithValue(ctx, csrfContextKey, token)
+}
+
+// GetCSRF returns the CSRF token stored in the context by WithCSRF, or an empty string.
+// The token is HTML escaped, so it's safe to use in attribute values.
+func GetCSRF(ctx context.Context) string {
+	token, _ := ctx.Value(csrfContextKey).(string)
+	return EscapeString(token)
+}
+
+// CSRFInputComponent renders a hidden input named _csrf containing the CSRF token stored
+// in ctx by WithCSRF.
+func CSRFInputComponent(ctx context.Context) Component {
+	token := GetCSRF(ctx)
+	return ComponentFunc(func(_ context.Context, w io.Writer) error {
+		return writeStrings(w, `<input type="hidden" name="_csrf" value="`, token, `">`)
+	})
+}
@@ -0,0 +1,53 @@
+package templ_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCSRF(t *testing.T) {
+	tests := []struct {
+		name          string
+		ctx           context.Context
+		expectedToken string
+		expectedInput string
+	}{
+		{
+			name:          "the token is empty if not set",
+			ctx:           context.Background(),
+			expectedToken: "",
+			expectedInput: `<input type="hidden" name="_csrf" value="">`,
+		},
+		{
+			name:          "the token is returned",
+			ctx:           templ.WithCSRF(context.Background(), "abc123"),
+			expectedToken: "abc123",
+			expectedInput: `<input type="hidden" name="_csrf" value="abc123">`,
+		},
+		{
+			name:          "the token is escaped",
+			ctx:           templ.WithCSRF(context.Background(), `a"b<c>&`),
+			expectedToken: "a&#34;b&lt;c&gt;&amp;",
+			expectedInput: `<input type="hidden" name="_csrf" value="a&#34;b&lt;c&gt;&amp;">`,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if diff := cmp.Diff(tt.expectedToken, templ.GetCSRF(tt.ctx)); diff != "" {
+				t.Error(diff)
+			}
+			b := new(bytes.Buffer)
+			if err := templ.CSRFInputComponent(tt.ctx).Render(tt.ctx, b); err != nil {
+				t.Fatalf("failed to render: %v", err)
+			}
+			if diff := cmp.Diff(tt.expectedInput, b.String()); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package templ
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/a-h/templ/safehtml"
+)
+
+// SafeCSSNested is CSS containing declarations and nested rules, e.g.
+// "color:red;&:hover{color:blue;}", that has been sanitized by SanitizeCSSNested.
+type SafeCSSNested string
+
+// nestedCSSSelector matches the selectors allowed in nested rules. Quotes, slashes,
+// backslashes, at-rules and angle brackets are not allowed.
+var nestedCSSSelector = regexp.MustCompile(`^[a-zA-Z0-9_\-.:#>+~*,() &\[\]=]+$`)
+
+// SanitizeCSSNested parses CSS made up of declarations and nested rules, and returns the
+// CSS with whitespace removed. Each property and value must pass the same sanitization as
+// SanitizeCSS, and selectors may only contain simple selectors, combinators, and pseudo
+// classes, such as &:hover, & > a, or :is(h1, h2).
+//
+// An error is returned if the CSS can't be parsed, or contains an unsafe selector, property
+// or value.
+func SanitizeCSSNested(css string) (SafeCSSNested, error) {
+	p := &nestedCSSParser{s: css}
+	var sb strings.Builder
+	if err := p.block(&sb, false); err != nil {
+		return "", err
+	}
+	return SafeCSSNested(sb.String()), nil
+}
+
+// NewNestedCSSClass creates a ComponentCSSClass from nested CSS, where the declarations
+// apply to the class, and nested rules apply relative to it.
+func NewNestedCSSClass(name string, css SafeCSSNested) ComponentCSSClass {
+	id := CSSIDWithLength(name, string(css), DefaultCSSIDLength)
+	return ComponentCSSClass{
+		ID:    id,
+		Class: SafeCSS("." + id + "{" + string(css) + "}"),
+	}
+}
+
+type nestedCSSParser struct {
+	s   string
+	pos int
+}
+
+func (p *nestedCSSParser) errorf(format string, args ...any) error {
+	return fmt.Errorf("templ: invalid nested CSS at position %d: %s", p.pos, fmt.Sprintf(format, args...))
+}
+
+// block parses declarations and rules until the end of the input, or, if nested, until
+// the closing brace of the rule.
+func (p *nestedCSSParser) block(sb *strings.Builder, nested bool) error {
+	for {
+		p.skipSpace()
+		if p.pos >= len(p.s) {
+			if nested {
+				return p.errorf("missing }")
+			}
+			return nil
+		}
+		if p.s[p.pos] == '}' {
+			if !nested {
+				return p.errorf("unexpected }")
+			}
+			p.pos++
+			return nil
+		}
+		if p.s[p.pos] == ';' {
+			p.pos++
+			continue
+		}
+		if err := p.item(sb); err != nil {
+			return err
+		}
+	}
+}
+
+// item parses a declaration, e.g. "color: red;", or a rule, e.g. "&:hover { color: red; }".
+// A rule is detected by a { before the next ; or }.
+func (p *nestedCSSParser) item(sb *strings.Builder) error {
+	end := strings.IndexAny(p.s[p.pos:], "{;}")
+	if end >= 0 && p.s[p.pos+end] == '{' {
+		selector := strings.TrimSpace(p.s[p.pos : p.pos+end])
+		if !nestedCSSSelector.MatchString(selector) || !balancedParens(selector) {
+			return p.errorf("unsafe selector %q", selector)
+		}
+		p.pos += end + 1
+		sb.WriteString(selector)
+		sb.WriteString("{")
+		if err := p.block(sb, true); err != nil {
+			return err
+		}
+		sb.WriteString("}")
+		return nil
+	}
+	if end < 0 {
+		end = len(p.s) - p.pos
+	}
+	declaration := p.s[p.pos : p.pos+end]
+	property, value, ok := strings.Cut(declaration, ":")
+	if !ok {
+		return p.errorf("expected a declaration, got %q", strings.TrimSpace(declaration))
+	}
+	property, value = strings.TrimSpace(property), strings.TrimSpace(value)
+	sanitizedProperty, sanitizedValue := safehtml.SanitizeCSS(property, value)
+	if sanitizedProperty == safehtml.InnocuousPropertyName {
+		return p.errorf("unsafe property %q", property)
+	}
+	if sanitizedValue == safehtml.InnocuousPropertyValue {
+		return p.errorf("unsafe value %q for property %q", value, property)
+	}
+	p.pos += end
+	sb.WriteString(sanitizedProperty)
+	sb.WriteString(":")
+	sb.WriteString(sanitizedValue)
+	sb.WriteString(";")
+	return nil
+}
+
+func (p *nestedCSSParser) skipSpace() {
+	for p.pos < len(p.s) && strings.IndexByte(" \t\r\n", p.s[p.pos]) >= 0 {
+		p.pos++
+	}
+}
+
+func balancedParens(s string) bool {
+	var depth int
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth < 0 {
+				return false
+			}
+		}
+	}
+	return depth == 0
+}
@@ -0,0 +1,98 @@
+package templ_test
+
+import (
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSanitizeCSSNested(t *testing.T) {
+	tests := []struct {
+		name          string
+		input         string
+		expected      templ.SafeCSSNested
+		expectedError bool
+	}{
+		{
+			name:     "declarations are allowed",
+			input:    "color: red; padding: 1em",
+			expected: "color:red;padding:1em;",
+		},
+		{
+			name:     "nested rules are allowed",
+			input:    "color: red;\n&:hover { color: blue; }\n& > a { text-decoration: none; }",
+			expected: "color:red;&:hover{color:blue;}& > a{text-decoration:none;}",
+		},
+		{
+			name:     "rules can be nested more than once",
+			input:    ":is(h1, h2) { margin: 0; &:where(.title) { font-weight: bold; } }",
+			expected: ":is(h1, h2){margin:0;&:where(.title){font-weight:bold;}}",
+		},
+		{
+			name:          "unsafe values are rejected",
+			input:         "&:hover { background: url(javascript:alert(1)); }",
+			expectedError: true,
+		},
+		{
+			name:          "unsafe properties are rejected",
+			input:         "x/**/y: red;",
+			expectedError: true,
+		},
+		{
+			name:          "selectors that close the style element are rejected",
+			input:         "</style><script>alert(1)</script>{color:red}",
+			expectedError: true,
+		},
+		{
+			name:          "at-rules are rejected",
+			input:         "@import url(https://example.com){color:red}",
+			expectedError: true,
+		},
+		{
+			name:          "unclosed rules are rejected",
+			input:         "&:hover { color: blue;",
+			expectedError: true,
+		},
+		{
+			name:          "unexpected closing braces are rejected",
+			input:         "color: red; } body { color: blue; }",
+			expectedError: true,
+		},
+		{
+			name:          "unbalanced parentheses are rejected",
+			input:         ":is(h1 { color: red; }",
+			expectedError: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := templ.SanitizeCSSNested(tt.input)
+			if tt.expectedError {
+				if err == nil {
+					t.Errorf("expected an error, got %q", actual)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.expected, actual); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestNewNestedCSSClass(t *testing.T) {
+	css, err := templ.SanitizeCSSNested("color: red; &:hover { color: blue; }")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	class := templ.NewNestedCSSClass("link", css)
+	expected := templ.SafeCSS("." + class.ID + "{color:red;&:hover{color:blue;}}")
+	if diff := cmp.Diff(expected, class.Class); diff != "" {
+		t.Error(diff)
+	}
+}
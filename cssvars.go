@@ -0,0 +1,83 @@
+package templ
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/a-h/templ/safehtml"
+)
+
+type cssVarsContextKeyType int
+
+const cssVarsContextKey = cssVarsContextKeyType(0)
+
+// WithCSSVars stores CSS custom properties in the context, to be rendered on :root by
+// RenderCSSVars. The leading -- of each name is optional. Variables are added to any
+// already stored in the context, replacing those with the same name.
+func WithCSSVars(ctx context.Context, vars map[string]SafeCSS) context.Context {
+	existing, _ := ctx.Value(cssVarsContextKey).(map[string]SafeCSS)
+	merged := make(map[string]SafeCSS, len(existing)+len(vars))
+	for name, value := range existing {
+		merged[name] = value
+	}
+	for name, value := range vars {
+		if !strings.HasPrefix(name, "--") {
+			name = "--" + name
+		}
+		merged[name] = value
+	}
+	return context.WithValue(ctx, cssVarsContextKey, merged)
+}
+
+// RenderCSSVars renders a <style> element that sets the CSS custom properties stored in the
+// context by WithCSSVars on :root, in name order. Variables that have already been rendered
+// in the context are skipped.
+//
+// Names must be valid custom property names, in the same way as SafeCSSVar. Invalid names
+// are skipped, and a warning is written in development mode. Values are sanitized in the
+// same way as SanitizeCSS values, so values that could escape the rule, or the <style>
+// element, are replaced with zTemplUnsafeCSSPropertyValue.
+func RenderCSSVars(ctx context.Context, w io.Writer) (err error) {
+	vars, _ := ctx.Value(cssVarsContextKey).(map[string]SafeCSS)
+	if len(vars) == 0 {
+		return nil
+	}
+	_, v := getContext(ctx)
+	names := make([]string, 0, len(vars))
+	for name := range vars {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	sb := new(strings.Builder)
+	for _, name := range names {
+		if !safehtml.CustomPropertyNamePattern.MatchString(name) {
+			if IsDevMode(ctx) {
+				fmt.Fprintf(devModeOutput, "templ: dev mode: skipped invalid CSS variable name %q\n", name)
+			}
+			continue
+		}
+		if v.hasCSSVarBeenRendered(name) {
+			continue
+		}
+		sb.WriteString(name)
+		sb.WriteString(":")
+		sb.WriteString(sanitizeCSSVarValue(name, string(vars[name])))
+		sb.WriteString(";")
+		v.addCSSVar(name)
+	}
+	if sb.Len() == 0 {
+		return nil
+	}
+	return writeStrings(w, `<style type="text/css">:root{`, sb.String(), `}</style>`)
+}
+
+// sanitizeCSSVarValue sanitizes the value of a custom property.
+func sanitizeCSSVarValue(name, value string) string {
+	if strings.ContainsAny(value, "<>{};") {
+		return safehtml.InnocuousPropertyValue
+	}
+	return safehtml.SanitizeCSSValue(name, value)
+}
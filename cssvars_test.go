@@ -0,0 +1,75 @@
+package templ_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRenderCSSVars(t *testing.T) {
+	t.Run("nothing is rendered if there are no variables", func(t *testing.T) {
+		b := new(bytes.Buffer)
+		if err := templ.RenderCSSVars(context.Background(), b); err != nil {
+			t.Fatalf("failed to render: %v", err)
+		}
+		if b.Len() != 0 {
+			t.Errorf("expected nothing to be rendered, got %q", b.String())
+		}
+	})
+	t.Run("variables are rendered once, in name order", func(t *testing.T) {
+		ctx := templ.InitializeContext(context.Background())
+		ctx = templ.WithCSSVars(ctx, map[string]templ.SafeCSS{
+			"--primary": "#2563eb",
+			"spacing":   "4px",
+			"bad name":  "red",
+		})
+		b := new(bytes.Buffer)
+		if err := templ.RenderCSSVars(ctx, b); err != nil {
+			t.Fatalf("failed to render: %v", err)
+		}
+		expected := `<style type="text/css">:root{--primary:#2563eb;--spacing:4px;}</style>`
+		if diff := cmp.Diff(expected, b.String()); diff != "" {
+			t.Error(diff)
+		}
+
+		ctx = templ.WithCSSVars(ctx, map[string]templ.SafeCSS{"radius": "2px"})
+		b.Reset()
+		if err := templ.RenderCSSVars(ctx, b); err != nil {
+			t.Fatalf("failed to render: %v", err)
+		}
+		expected = `<style type="text/css">:root{--radius:2px;}</style>`
+		if diff := cmp.Diff(expected, b.String()); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("values are sanitized", func(t *testing.T) {
+		ctx := templ.WithCSSVars(context.Background(), map[string]templ.SafeCSS{
+			"a-style-breakout": "red}</style><script>alert(1)</script>",
+			"b-rule-breakout":  "red}body{background:red",
+			"c-declaration":    "red;background:red",
+			"d-block":          "{color:red}",
+			"e-url":            "url(javascript:alert(1))",
+			"f-calc":           "calc(1px + 2px)",
+			"g-var":            "var(--primary)",
+		})
+		b := new(bytes.Buffer)
+		if err := templ.RenderCSSVars(ctx, b); err != nil {
+			t.Fatalf("failed to render: %v", err)
+		}
+		expected := `<style type="text/css">:root{` +
+			`--a-style-breakout:zTemplUnsafeCSSPropertyValue;` +
+			`--b-rule-breakout:zTemplUnsafeCSSPropertyValue;` +
+			`--c-declaration:zTemplUnsafeCSSPropertyValue;` +
+			`--d-block:zTemplUnsafeCSSPropertyValue;` +
+			`--e-url:zTemplUnsafeCSSPropertyValue;` +
+			`--f-calc:calc(1px + 2px);` +
+			`--g-var:var(--primary);` +
+			`}</style>`
+		if diff := cmp.Diff(expected, b.String()); diff != "" {
+			t.Error(diff)
+		}
+	})
+}
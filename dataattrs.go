@@ -0,0 +1,38 @@
+package templ
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+)
+
+// DataAttrs are data-* attributes, keyed by the name of the attribute without the data- prefix.
+type DataAttrs map[string]string
+
+var dataAttrKey = regexp.MustCompile(`^[a-z0-9][a-z0-9_.-]*$`)
+
+// RenderDataAttrs writes each attribute as data-key="value", in key order, with the value escaped.
+//
+// Keys must be lowercase, and can only contain letters, digits, hyphens, underscores and
+// periods. Invalid keys are skipped, and a warning is written in development mode.
+func RenderDataAttrs(ctx context.Context, w io.Writer, attrs DataAttrs) (err error) {
+	keys := make([]string, 0, len(attrs))
+	for k := range attrs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if !dataAttrKey.MatchString(k) {
+			if IsDevMode(ctx) {
+				fmt.Fprintf(devModeOutput, "templ: dev mode: skipped invalid data attribute key %q\n", k)
+			}
+			continue
+		}
+		if err = writeStrings(w, ` data-`, k, `="`, EscapeString(attrs[k]), `"`); err != nil {
+			return err
+		}
+	}
+	return nil
+}
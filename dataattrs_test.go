@@ -0,0 +1,58 @@
+package templ_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRenderDataAttrs(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    templ.DataAttrs
+		expected string
+	}{
+		{
+			name:     "no attributes",
+			input:    nil,
+			expected: ``,
+		},
+		{
+			name:     "attributes are rendered in key order",
+			input:    templ.DataAttrs{"user-id": "123", "action": "delete"},
+			expected: ` data-action="delete" data-user-id="123"`,
+		},
+		{
+			name:     "values are escaped",
+			input:    templ.DataAttrs{"title": `"><script>`},
+			expected: ` data-title="&#34;&gt;&lt;script&gt;"`,
+		},
+		{
+			name: "invalid keys are skipped",
+			input: templ.DataAttrs{
+				"userId":          "uppercase",
+				"":                "empty",
+				"-leading":        "hyphen",
+				`x" onclick="a()`: "injection",
+				"x y":             "space",
+				"valid_key.1":     "ok",
+			},
+			expected: ` data-valid_key.1="ok"`,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			b := new(bytes.Buffer)
+			if err := templ.RenderDataAttrs(context.Background(), b, tt.input); err != nil {
+				t.Fatalf("failed to render: %v", err)
+			}
+			if diff := cmp.Diff(tt.expected, b.String()); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,9 @@
+package templ
+
+import "encoding/base64"
+
+// EncodeBase64DataURI returns a data: URI containing the base64 encoded data, e.g. for use
+// as the src of an <img>. The mime type is not validated, so must not be user controlled.
+func EncodeBase64DataURI(mimeType string, data []byte) SafeURL {
+	return SafeURL("data:" + mimeType + ";base64," + base64.StdEncoding.EncodeToString(data))
+}
@@ -0,0 +1,15 @@
+package templ_test
+
+import (
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestEncodeBase64DataURI(t *testing.T) {
+	expected := templ.SafeURL("data:text/plain;base64,SGVsbG8sIHdvcmxkLg==")
+	if diff := cmp.Diff(expected, templ.EncodeBase64DataURI("text/plain", []byte("Hello, world."))); diff != "" {
+		t.Error(diff)
+	}
+}
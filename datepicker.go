@@ -0,0 +1,185 @@
+package templ
+
+import (
+	"context"
+	"io"
+	"strconv"
+	"time"
+)
+
+// DatePickerOption configures a date picker.
+type DatePickerOption func(*datePickerConfig)
+
+type datePickerConfig struct {
+	customPicker bool
+}
+
+// WithCustomPicker replaces the browser's native date input with a text input, and an
+// accessible calendar dialog, so that the date picker looks the same in all browsers.
+func WithCustomPicker(enabled bool) DatePickerOption {
+	return func(c *datePickerConfig) {
+		c.customPicker = enabled
+	}
+}
+
+const dateFormat = "2006-01-02"
+
+// NewDatePicker renders a labelled date input. The value must be formatted as YYYY-MM-DD.
+// If min or max are zero, the range of dates is not limited.
+func NewDatePicker(name, label, value string, min, max time.Time, opts ...DatePickerOption) Component {
+	var config datePickerConfig
+	for _, o := range opts {
+		o(&config)
+	}
+	minValue, maxValue := formatDate(min), formatDate(max)
+	if config.customPicker {
+		return customDatePicker(name, label, value, minValue, maxValue, min)
+	}
+	return ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		id := EscapeString(name)
+		if err = writeStrings(w,
+			`<div class="date-picker">`,
+			`<label for="`, id, `">`, EscapeString(label), `</label>`,
+			`<input type="date" id="`, id, `" name="`, id, `" value="`, EscapeString(value), `"`); err != nil {
+			return err
+		}
+		if minValue != "" {
+			if err = writeStrings(w, ` min="`, minValue, `"`); err != nil {
+				return err
+			}
+		}
+		if maxValue != "" {
+			if err = writeStrings(w, ` max="`, maxValue, `"`); err != nil {
+				return err
+			}
+		}
+		_, err = io.WriteString(w, `></div>`)
+		return err
+	})
+}
+
+func formatDate(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(dateFormat)
+}
+
+func customDatePicker(name, label, value, minValue, maxValue string, min time.Time) Component {
+	return ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		id := EscapeString(name)
+		calendarID := id + "-calendar"
+		// Display the month of the selected date, or the first available month.
+		month, parseErr := time.Parse(dateFormat, value)
+		if parseErr != nil {
+			month = min
+			if month.IsZero() {
+				month = time.Now()
+			}
+		}
+		if err = writeStrings(w,
+			`<div class="date-picker" data-date-picker>`,
+			`<label for="`, id, `">`, EscapeString(label), `</label>`,
+			`<input type="text" id="`, id, `" name="`, id, `" value="`, EscapeString(value),
+			`" placeholder="YYYY-MM-DD" pattern="\d{4}-\d{2}-\d{2}" autocomplete="off" data-min="`, minValue, `" data-max="`, maxValue, `">`,
+			`<button type="button" aria-label="Choose date" aria-haspopup="dialog" aria-expanded="false" aria-controls="`, calendarID, `" data-date-picker-toggle>&#128197;</button>`,
+			`<div id="`, calendarID, `" role="dialog" aria-label="`, EscapeString(label), `" data-year="`, strconv.Itoa(month.Year()),
+			`" data-month="`, strconv.Itoa(int(month.Month())-1), `" hidden>`); err != nil {
+			return err
+		}
+		if err = renderCalendar(w, month, value, minValue, maxValue); err != nil {
+			return err
+		}
+		if _, err = io.WriteString(w, `</div></div>`); err != nil {
+			return err
+		}
+		return datePickerScript.Render(ctx, w)
+	})
+}
+
+var calendarWeekdays = []string{"Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday", "Sunday"}
+
+// renderCalendar renders a grid of the days in the month. Weeks start on Monday.
+// Dates outside of the min and max (YYYY-MM-DD) values are disabled.
+func renderCalendar(w io.Writer, month time.Time, selected, minValue, maxValue string) (err error) {
+	first := time.Date(month.Year(), month.Month(), 1, 0, 0, 0, 0, time.UTC)
+	if err = writeStrings(w,
+		`<div class="calendar-header">`,
+		`<button type="button" aria-label="Previous month" data-calendar-prev>&lsaquo;</button>`,
+		`<span aria-live="polite" data-calendar-caption>`, first.Format("January 2006"), `</span>`,
+		`<button type="button" aria-label="Next month" data-calendar-next>&rsaquo;</button>`,
+		`</div>`,
+		`<table role="grid"><thead><tr>`); err != nil {
+		return err
+	}
+	for _, day := range calendarWeekdays {
+		if err = writeStrings(w, `<th scope="col" abbr="`, day, `">`, day[:2], `</th>`); err != nil {
+			return err
+		}
+	}
+	if _, err = io.WriteString(w, `</tr></thead><tbody><tr>`); err != nil {
+		return err
+	}
+	offset := (int(first.Weekday()) + 6) % 7
+	for i := 0; i < offset; i++ {
+		if _, err = io.WriteString(w, `<td></td>`); err != nil {
+			return err
+		}
+	}
+	for d := first; d.Month() == first.Month(); d = d.AddDate(0, 0, 1) {
+		if d.Day() > 1 && (offset+d.Day()-1)%7 == 0 {
+			if _, err = io.WriteString(w, `</tr><tr>`); err != nil {
+				return err
+			}
+		}
+		date := d.Format(dateFormat)
+		if err = writeStrings(w, `<td><button type="button" tabindex="-1" data-date="`, date,
+			`" aria-selected="`, strconv.FormatBool(date == selected), `"`); err != nil {
+			return err
+		}
+		if (minValue != "" && date < minValue) || (maxValue != "" && date > maxValue) {
+			if _, err = io.WriteString(w, ` disabled`); err != nil {
+				return err
+			}
+		}
+		if err = writeStrings(w, `>`, strconv.Itoa(d.Day()), `</button></td>`); err != nil {
+			return err
+		}
+	}
+	_, err = io.WriteString(w, `</tr></tbody></table>`)
+	return err
+}
+
+var datePickerScript = ComponentScript{
+	Name: `__templ_datePicker`,
+	Function: `(function(){` +
+		`function pad(n){return (n<10?"0":"")+n;}` +
+		`function fmt(d){return d.getFullYear()+"-"+pad(d.getMonth()+1)+"-"+pad(d.getDate());}` +
+		`function parts(picker){return {input:picker.querySelector("input"),toggle:picker.querySelector("[data-date-picker-toggle]"),dialog:picker.querySelector("[role=dialog]")};}` +
+		`function render(picker,year,month){var p=parts(picker);var first=new Date(year,month,1);` +
+		`year=first.getFullYear();month=first.getMonth();p.dialog.setAttribute("data-year",year);p.dialog.setAttribute("data-month",month);` +
+		`p.dialog.querySelector("[data-calendar-caption]").textContent=first.toLocaleDateString(undefined,{month:"long",year:"numeric"});` +
+		`var min=p.input.getAttribute("data-min"),max=p.input.getAttribute("data-max");` +
+		`var tbody=p.dialog.querySelector("tbody");tbody.innerHTML="";var row=document.createElement("tr");` +
+		`for(var i=0;i<(first.getDay()+6)%7;i++){row.appendChild(document.createElement("td"));}` +
+		`for(var d=1;d<=new Date(year,month+1,0).getDate();d++){` +
+		`if(row.children.length===7){tbody.appendChild(row);row=document.createElement("tr");}` +
+		`var v=fmt(new Date(year,month,d));var b=document.createElement("button");b.type="button";b.tabIndex=-1;b.textContent=d;` +
+		`b.setAttribute("data-date",v);b.setAttribute("aria-selected",String(v===p.input.value));b.disabled=!!((min&&v<min)||(max&&v>max));` +
+		`var td=document.createElement("td");td.appendChild(b);row.appendChild(td);}` +
+		`tbody.appendChild(row);}` +
+		`function focusDay(picker){var p=parts(picker);var b=p.dialog.querySelector("[data-date][aria-selected=true]:not([disabled])")||p.dialog.querySelector("[data-date]:not([disabled])");if(b){b.focus();}}` +
+		`function show(picker,open){var p=parts(picker);p.dialog.hidden=!open;p.toggle.setAttribute("aria-expanded",String(open));` +
+		`if(open){var m=/^(\d{4})-(\d{2})-\d{2}$/.exec(p.input.value);if(m){render(picker,+m[1],+m[2]-1);}focusDay(picker);}}` +
+		`function move(picker,by){var p=parts(picker);render(picker,+p.dialog.getAttribute("data-year"),+p.dialog.getAttribute("data-month")+by);}` +
+		`document.addEventListener("click",function(e){var t=e.target.closest&&e.target.closest("button");var picker=t&&t.closest("[data-date-picker]");if(!picker){return;}` +
+		`if(t.hasAttribute("data-date-picker-toggle")){show(picker,parts(picker).dialog.hidden);}` +
+		`else if(t.hasAttribute("data-calendar-prev")){move(picker,-1);}` +
+		`else if(t.hasAttribute("data-calendar-next")){move(picker,1);}` +
+		`else if(t.hasAttribute("data-date")){var p=parts(picker);p.input.value=t.getAttribute("data-date");show(picker,false);p.input.focus();}});` +
+		`document.addEventListener("keydown",function(e){var t=e.target;var picker=t.closest&&t.closest("[data-date-picker]");if(!picker||parts(picker).dialog.hidden){return;}` +
+		`if(e.key==="Escape"){show(picker,false);parts(picker).toggle.focus();return;}` +
+		`if(!t.hasAttribute("data-date")){return;}var step={ArrowLeft:-1,ArrowRight:1,ArrowUp:-7,ArrowDown:7}[e.key];if(!step){return;}e.preventDefault();` +
+		`var days=Array.prototype.slice.call(parts(picker).dialog.querySelectorAll("[data-date]"));var next=days[days.indexOf(t)+step];if(next){next.focus();}});` +
+		`})();`,
+}
@@ -0,0 +1,69 @@
+package templ_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/a-h/templ"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDatePicker(t *testing.T) {
+	min := time.Date(2024, time.February, 10, 0, 0, 0, 0, time.UTC)
+	max := time.Date(2024, time.February, 20, 0, 0, 0, 0, time.UTC)
+
+	t.Run("the native date input is used by default", func(t *testing.T) {
+		b := new(bytes.Buffer)
+		if err := templ.NewDatePicker("start", "Start date", "2024-02-14", min, max).Render(context.Background(), b); err != nil {
+			t.Fatalf("failed to render: %v", err)
+		}
+		expected := `<div class="date-picker"><label for="start">Start date</label>` +
+			`<input type="date" id="start" name="start" value="2024-02-14" min="2024-02-10" max="2024-02-20"></div>`
+		if diff := cmp.Diff(expected, b.String()); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("zero min and max values are omitted", func(t *testing.T) {
+		b := new(bytes.Buffer)
+		if err := templ.NewDatePicker("start", "Start date", "", time.Time{}, time.Time{}).Render(context.Background(), b); err != nil {
+			t.Fatalf("failed to render: %v", err)
+		}
+		expected := `<div class="date-picker"><label for="start">Start date</label>` +
+			`<input type="date" id="start" name="start" value=""></div>`
+		if diff := cmp.Diff(expected, b.String()); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("the custom picker renders a calendar of the selected month", func(t *testing.T) {
+		b := new(bytes.Buffer)
+		err := templ.NewDatePicker("start", "Start date", "2024-02-14", min, max, templ.WithCustomPicker(true)).Render(context.Background(), b)
+		if err != nil {
+			t.Fatalf("failed to render: %v", err)
+		}
+		output := b.String()
+		for _, expected := range []string{
+			`<input type="text" id="start" name="start" value="2024-02-14"`,
+			`data-min="2024-02-10" data-max="2024-02-20"`,
+			`aria-controls="start-calendar"`,
+			`<div id="start-calendar" role="dialog" aria-label="Start date" data-year="2024" data-month="1" hidden>`,
+			`<span aria-live="polite" data-calendar-caption>February 2024</span>`,
+			// 1st February 2024 was a Thursday.
+			`<tbody><tr><td></td><td></td><td></td><td><button type="button" tabindex="-1" data-date="2024-02-01" aria-selected="false" disabled>1</button></td>`,
+			`<button type="button" tabindex="-1" data-date="2024-02-14" aria-selected="true">14</button>`,
+			`<button type="button" tabindex="-1" data-date="2024-02-20" aria-selected="false">20</button>`,
+			`<button type="button" tabindex="-1" data-date="2024-02-21" aria-selected="false" disabled>21</button>`,
+			`<td><button type="button" tabindex="-1" data-date="2024-02-29" aria-selected="false" disabled>29</button></td></tr></tbody>`,
+			`<script type="text/javascript">`,
+		} {
+			if !strings.Contains(output, expected) {
+				t.Errorf("expected output to contain %q", expected)
+			}
+		}
+		if rows := strings.Count(output, "<tr>"); rows != 6 {
+			t.Errorf("expected a header row, and 5 weeks, got %d rows", rows)
+		}
+	})
+}
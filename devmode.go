@@ -0,0 +1,154 @@
+package templ
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"reflect"
+	"runtime"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+type devModeContextKeyType int
+
+const devModeContextKey = devModeContextKeyType(0)
+
+type devModeContextValue struct {
+	enabled bool
+	// validating is true when the output is being validated by a parent component.
+	validating bool
+}
+
+// WithDevMode enables or disables development mode checks for components rendered
+// with the returned context.
+//
+// In development mode, the output of each top-level ComponentFunc is parsed, and
+// warnings are written to stderr if the output is malformed HTML, e.g. an element is
+// not closed, or if an img element is missing an alt attribute. The output itself is
+// not modified.
+//
+// Development mode buffers the output of components, so it should not be used in
+// production.
+func WithDevMode(ctx context.Context, enabled bool) context.Context {
+	if enabled {
+		renderHooksEnabled.Store(true)
+	}
+	return context.WithValue(ctx, devModeContextKey, devModeContextValue{enabled: enabled})
+}
+
+// IsDevMode returns true if development mode has been enabled with WithDevMode.
+func IsDevMode(ctx context.Context) bool {
+	v, _ := ctx.Value(devModeContextKey).(devModeContextValue)
+	return v.enabled
+}
+
+// DevMiddleware enables development mode for all requests.
+func DevMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		next.ServeHTTP(w, r.WithContext(WithDevMode(r.Context(), true)))
+	})
+}
+
+// devModeOutput is where development mode warnings are written.
+var devModeOutput io.Writer = os.Stderr
+
+// renderWithDevMode renders the component function to a buffer, and validates the output
+// before writing it. Components rendered by the component are not validated separately.
+func renderWithDevMode(ctx context.Context, w io.Writer, cf ComponentFunc) error {
+	ctx = context.WithValue(ctx, devModeContextKey, devModeContextValue{enabled: true, validating: true})
+	var b bytes.Buffer
+	err := cf.Render(ctx, &b)
+	if err == nil {
+		name := componentFuncName(cf)
+		for _, warning := range validateHTML(b.Bytes()) {
+			fmt.Fprintf(devModeOutput, "templ: dev mode: %s: %s\n", name, warning)
+		}
+	}
+	if _, writeErr := w.Write(b.Bytes()); writeErr != nil && err == nil {
+		err = writeErr
+	}
+	return err
+}
+
+func componentFuncName(cf ComponentFunc) string {
+	if f := runtime.FuncForPC(reflect.ValueOf(cf).Pointer()); f != nil {
+		return strings.TrimSuffix(f.Name(), ".func1")
+	}
+	return "unknown component"
+}
+
+var voidElements = map[string]struct{}{
+	"area": {}, "base": {}, "br": {}, "col": {}, "embed": {}, "hr": {}, "img": {}, "input": {},
+	"link": {}, "meta": {}, "param": {}, "source": {}, "track": {}, "wbr": {},
+}
+
+// optionalEndTagElements are elements where the HTML specification allows the end tag to be omitted.
+var optionalEndTagElements = map[string]struct{}{
+	"html": {}, "head": {}, "body": {}, "p": {}, "li": {}, "dt": {}, "dd": {}, "option": {}, "optgroup": {},
+	"rb": {}, "rp": {}, "rt": {}, "rtc": {}, "tr": {}, "td": {}, "th": {}, "thead": {}, "tbody": {},
+	"tfoot": {}, "colgroup": {}, "caption": {},
+}
+
+// validateHTML returns warnings about malformed HTML and missing accessibility attributes.
+func validateHTML(b []byte) (warnings []string) {
+	var open []string
+	z := html.NewTokenizer(bytes.NewReader(b))
+	for {
+		tt := z.Next()
+		switch tt {
+		case html.ErrorToken:
+			if err := z.Err(); !errors.Is(err, io.EOF) {
+				warnings = append(warnings, fmt.Sprintf("invalid HTML: %v", err))
+			}
+			for i := len(open) - 1; i >= 0; i-- {
+				if _, ok := optionalEndTagElements[open[i]]; !ok {
+					warnings = append(warnings, fmt.Sprintf("<%s> is not closed", open[i]))
+				}
+			}
+			return warnings
+		case html.StartTagToken, html.SelfClosingTagToken:
+			t := z.Token()
+			if t.Data == "img" && !hasAttr(t, "alt") {
+				warnings = append(warnings, "<img> is missing an alt attribute")
+			}
+			if _, isVoid := voidElements[t.Data]; isVoid {
+				continue
+			}
+			if tt == html.SelfClosingTagToken {
+				warnings = append(warnings, fmt.Sprintf("<%s/> is not a void element, and can't be self-closing", t.Data))
+			}
+			open = append(open, t.Data)
+		case html.EndTagToken:
+			t := z.Token()
+			i := len(open) - 1
+			for i >= 0 && open[i] != t.Data {
+				i--
+			}
+			if i < 0 {
+				warnings = append(warnings, fmt.Sprintf("</%s> does not have a matching start tag", t.Data))
+				continue
+			}
+			for _, unclosed := range open[i+1:] {
+				if _, ok := optionalEndTagElements[unclosed]; !ok {
+					warnings = append(warnings, fmt.Sprintf("<%s> is not closed before </%s>", unclosed, t.Data))
+				}
+			}
+			open = open[:i]
+		}
+	}
+}
+
+func hasAttr(t html.Token, name string) bool {
+	for _, a := range t.Attr {
+		if a.Key == name {
+			return true
+		}
+	}
+	return false
+}
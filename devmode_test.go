@@ -0,0 +1,139 @@
+package templ
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestValidateHTML(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected []string
+	}{
+		{
+			name:  "well formed HTML has no warnings",
+			input: `<div><p>Text<br><img src="a.png" alt="A"></p><input type="text"/></div>`,
+		},
+		{
+			name:  "optional end tags can be omitted",
+			input: `<ul><li>One<li>Two</ul><table><tr><td>Cell</table>`,
+		},
+		{
+			name:  "script content is not parsed",
+			input: `<script>if (a < b) { document.write("</div>") }</script>`,
+		},
+		{
+			name:  "full documents are supported",
+			input: `<!DOCTYPE html><html><head><title>Title</title></head><body><!-- comment --></body></html>`,
+		},
+		{
+			name:     "unclosed elements are reported",
+			input:    `<div><span>Text</div><section>`,
+			expected: []string{"<span> is not closed before </div>", "<section> is not closed"},
+		},
+		{
+			name:     "unmatched end tags are reported",
+			input:    `<div></div></span>`,
+			expected: []string{"</span> does not have a matching start tag"},
+		},
+		{
+			name:     "self-closing non-void elements are reported",
+			input:    `<div/>`,
+			expected: []string{"<div/> is not a void element, and can't be self-closing", "<div> is not closed"},
+		},
+		{
+			name:     "images without alt attributes are reported",
+			input:    `<img src="a.png">`,
+			expected: []string{"<img> is missing an alt attribute"},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			actual := validateHTML([]byte(tt.input))
+			if diff := cmp.Diff(tt.expected, actual); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestDevMode(t *testing.T) {
+	var warnings bytes.Buffer
+	devModeOutput = &warnings
+	defer func() { devModeOutput = os.Stderr }()
+
+	child := ComponentFunc(func(ctx context.Context, w io.Writer) error {
+		_, err := io.WriteString(w, `<span>`)
+		return err
+	})
+	parent := ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		if err = child.Render(ctx, w); err != nil {
+			return err
+		}
+		_, err = io.WriteString(w, `</span>`)
+		return err
+	})
+
+	t.Run("the output of components is validated when dev mode is enabled", func(t *testing.T) {
+		warnings.Reset()
+		b := new(bytes.Buffer)
+		if err := child.Render(WithDevMode(context.Background(), true), b); err != nil {
+			t.Fatalf("failed to render: %v", err)
+		}
+		if b.String() != "<span>" {
+			t.Errorf("expected the output to be unchanged, got %q", b.String())
+		}
+		if !strings.Contains(warnings.String(), "templ: dev mode: ") || !strings.Contains(warnings.String(), "<span> is not closed") {
+			t.Errorf("expected a warning, got %q", warnings.String())
+		}
+	})
+	t.Run("only the output of the top-level component is validated", func(t *testing.T) {
+		warnings.Reset()
+		if err := parent.Render(WithDevMode(context.Background(), true), io.Discard); err != nil {
+			t.Fatalf("failed to render: %v", err)
+		}
+		if warnings.Len() != 0 {
+			t.Errorf("expected no warnings, got %q", warnings.String())
+		}
+	})
+	t.Run("output is not validated when dev mode is disabled", func(t *testing.T) {
+		warnings.Reset()
+		for _, ctx := range []context.Context{context.Background(), WithDevMode(context.Background(), false)} {
+			if err := child.Render(ctx, io.Discard); err != nil {
+				t.Fatalf("failed to render: %v", err)
+			}
+		}
+		if warnings.Len() != 0 {
+			t.Errorf("expected no warnings, got %q", warnings.String())
+		}
+	})
+	t.Run("invalid data attribute keys are reported", func(t *testing.T) {
+		warnings.Reset()
+		if err := RenderDataAttrs(WithDevMode(context.Background(), true), io.Discard, DataAttrs{"userId": "1"}); err != nil {
+			t.Fatalf("failed to render: %v", err)
+		}
+		if !strings.Contains(warnings.String(), `invalid data attribute key "userId"`) {
+			t.Errorf("expected a warning, got %q", warnings.String())
+		}
+	})
+	t.Run("the middleware enables dev mode", func(t *testing.T) {
+		var enabled bool
+		h := DevMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			enabled = IsDevMode(r.Context())
+		}))
+		h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+		if !enabled {
+			t.Error("expected dev mode to be enabled")
+		}
+	})
+}
@@ -0,0 +1,164 @@
+package templ
+
+import (
+	"context"
+	"io"
+	"strings"
+)
+
+// DiffMode is the layout of a diff view.
+type DiffMode int
+
+const (
+	// DiffUnified renders the changes in a single column.
+	DiffUnified DiffMode = iota
+	// DiffSideBySide renders the original and modified text in two columns.
+	DiffSideBySide
+)
+
+type diffOp int
+
+const (
+	diffEqual diffOp = iota
+	diffDelete
+	diffInsert
+)
+
+type diffLine struct {
+	op   diffOp
+	text string
+}
+
+// diffLines returns the line by line difference between a and b, using the longest
+// common subsequence of lines.
+func diffLines(a, b []string) (lines []diffLine) {
+	// lcs[i][j] is the length of the longest common subsequence of a[i:] and b[j:].
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+	var i, j int
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			lines = append(lines, diffLine{op: diffEqual, text: a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			lines = append(lines, diffLine{op: diffDelete, text: a[i]})
+			i++
+		default:
+			lines = append(lines, diffLine{op: diffInsert, text: b[j]})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		lines = append(lines, diffLine{op: diffDelete, text: a[i]})
+	}
+	for ; j < len(b); j++ {
+		lines = append(lines, diffLine{op: diffInsert, text: b[j]})
+	}
+	return lines
+}
+
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
+
+// NewDiffView renders the line by line difference between the original and modified text.
+// Removed lines are rendered in del elements, added lines in ins elements, and unchanged
+// lines in span elements.
+func NewDiffView(original, modified string, mode DiffMode) Component {
+	return ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		lines := diffLines(splitLines(original), splitLines(modified))
+		if mode == DiffSideBySide {
+			return renderSideBySideDiff(w, lines)
+		}
+		if _, err = io.WriteString(w, `<pre class="diff diff-unified">`); err != nil {
+			return err
+		}
+		if err = renderDiffLines(w, lines); err != nil {
+			return err
+		}
+		_, err = io.WriteString(w, `</pre>`)
+		return err
+	})
+}
+
+var diffLineElements = map[diffOp]string{
+	diffEqual:  "span",
+	diffDelete: "del",
+	diffInsert: "ins",
+}
+
+func renderDiffLines(w io.Writer, lines []diffLine) (err error) {
+	for _, l := range lines {
+		el := diffLineElements[l.op]
+		if err = writeStrings(w, `<`, el, `>`, EscapeString(l.text), "\n", `</`, el, `>`); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// renderSideBySideDiff renders the original and modified lines in two columns. Removed and
+// added lines are aligned, and padded with empty lines where the number of lines differ.
+func renderSideBySideDiff(w io.Writer, lines []diffLine) (err error) {
+	var left, right []diffLine
+	padding := diffLine{op: diffEqual}
+	var deleted, inserted []diffLine
+	flush := func() {
+		for i := 0; i < max(len(deleted), len(inserted)); i++ {
+			if i < len(deleted) {
+				left = append(left, deleted[i])
+			} else {
+				left = append(left, padding)
+			}
+			if i < len(inserted) {
+				right = append(right, inserted[i])
+			} else {
+				right = append(right, padding)
+			}
+		}
+		deleted, inserted = nil, nil
+	}
+	for _, l := range lines {
+		switch l.op {
+		case diffDelete:
+			deleted = append(deleted, l)
+		case diffInsert:
+			inserted = append(inserted, l)
+		default:
+			flush()
+			left = append(left, l)
+			right = append(right, l)
+		}
+	}
+	flush()
+	if _, err = io.WriteString(w, `<div class="diff diff-side-by-side"><pre class="diff-original">`); err != nil {
+		return err
+	}
+	if err = renderDiffLines(w, left); err != nil {
+		return err
+	}
+	if _, err = io.WriteString(w, `</pre><pre class="diff-modified">`); err != nil {
+		return err
+	}
+	if err = renderDiffLines(w, right); err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, `</pre></div>`)
+	return err
+}
@@ -0,0 +1,68 @@
+package templ_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDiffView(t *testing.T) {
+	original := "a\nb\nc\nd\n"
+	modified := "a\nB\nc\nd\n<e>\n"
+	tests := []struct {
+		name     string
+		original string
+		modified string
+		mode     templ.DiffMode
+		expected string
+	}{
+		{
+			name:     "identical text is rendered as context",
+			original: "a\nb",
+			modified: "a\nb\n",
+			mode:     templ.DiffUnified,
+			expected: "<pre class=\"diff diff-unified\"><span>a\n</span><span>b\n</span></pre>",
+		},
+		{
+			name:     "unified",
+			original: original,
+			modified: modified,
+			mode:     templ.DiffUnified,
+			expected: "<pre class=\"diff diff-unified\">" +
+				"<span>a\n</span><del>b\n</del><ins>B\n</ins><span>c\n</span><span>d\n</span><ins>&lt;e&gt;\n</ins>" +
+				"</pre>",
+		},
+		{
+			name:     "side by side",
+			original: original,
+			modified: modified,
+			mode:     templ.DiffSideBySide,
+			expected: "<div class=\"diff diff-side-by-side\">" +
+				"<pre class=\"diff-original\"><span>a\n</span><del>b\n</del><span>c\n</span><span>d\n</span><span>\n</span></pre>" +
+				"<pre class=\"diff-modified\"><span>a\n</span><ins>B\n</ins><span>c\n</span><span>d\n</span><ins>&lt;e&gt;\n</ins></pre>" +
+				"</div>",
+		},
+		{
+			name:     "empty original",
+			original: "",
+			modified: "a\n",
+			mode:     templ.DiffUnified,
+			expected: "<pre class=\"diff diff-unified\"><ins>a\n</ins></pre>",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			b := new(bytes.Buffer)
+			if err := templ.NewDiffView(tt.original, tt.modified, tt.mode).Render(context.Background(), b); err != nil {
+				t.Fatalf("failed to render: %v", err)
+			}
+			if diff := cmp.Diff(tt.expected, b.String()); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package templ
+
+import (
+	"context"
+	"io"
+)
+
+// DraggableItem is an item of a drag and drop list.
+type DraggableItem struct {
+	// Value submitted with the form.
+	Value string
+	// Label displayed to the user.
+	Label string
+}
+
+// NewDragDropList renders a list of items that can be reordered by dragging, or with the
+// keyboard, by pressing Space to grab an item, the arrow keys to move it, and Space to
+// drop it.
+//
+// Each item's value is submitted in a hidden input with the given name, in the order of
+// the list.
+func NewDragDropList(name string, items []DraggableItem) Component {
+	return ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		n := EscapeString(name)
+		if err = writeStrings(w, `<ul class="drag-drop-list" role="listbox" aria-label="Reorder items" data-drag-drop-list>`); err != nil {
+			return err
+		}
+		for _, item := range items {
+			if err = writeStrings(w,
+				`<li role="option" draggable="true" tabindex="0" aria-grabbed="false" aria-selected="false">`, EscapeString(item.Label),
+				`<input type="hidden" name="`, n, `" value="`, EscapeString(item.Value), `"></li>`); err != nil {
+				return err
+			}
+		}
+		if _, err = io.WriteString(w, `</ul>`); err != nil {
+			return err
+		}
+		return dragDropListScript.Render(ctx, w)
+	})
+}
+
+var dragDropListScript = ComponentScript{
+	Name: `__templ_dragDropList`,
+	Function: `(function(){` +
+		`var dragged=null;` +
+		`function item(e){return e.target.closest&&e.target.closest("[data-drag-drop-list] > li");}` +
+		`document.addEventListener("dragstart",function(e){var li=item(e);if(!li){return;}dragged=li;li.setAttribute("aria-grabbed","true");e.dataTransfer.effectAllowed="move";});` +
+		`document.addEventListener("dragover",function(e){var li=item(e);if(!li||!dragged||li.parentNode!==dragged.parentNode){return;}e.preventDefault();` +
+		`var rect=li.getBoundingClientRect();li.parentNode.insertBefore(dragged,e.clientY>rect.top+rect.height/2?li.nextSibling:li);});` +
+		`document.addEventListener("drop",function(e){if(dragged&&item(e)){e.preventDefault();}});` +
+		`document.addEventListener("dragend",function(){if(dragged){dragged.setAttribute("aria-grabbed","false");dragged=null;}});` +
+		`document.addEventListener("keydown",function(e){var li=item(e);if(!li){return;}var grabbed=li.getAttribute("aria-grabbed")==="true";` +
+		`if(e.key===" "){e.preventDefault();li.setAttribute("aria-grabbed",String(!grabbed));li.setAttribute("aria-selected",String(!grabbed));return;}` +
+		`var sibling=e.key==="ArrowUp"?li.previousElementSibling:e.key==="ArrowDown"?li.nextElementSibling:null;if(!sibling){return;}e.preventDefault();` +
+		`if(grabbed){li.parentNode.insertBefore(li,e.key==="ArrowUp"?sibling:sibling.nextSibling);li.focus();}else{sibling.focus();}});` +
+		`})();`,
+}
@@ -0,0 +1,33 @@
+package templ_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDragDropList(t *testing.T) {
+	items := []templ.DraggableItem{
+		{Value: "1", Label: "First"},
+		{Value: "2", Label: "<Second>"},
+	}
+	b := new(bytes.Buffer)
+	if err := templ.NewDragDropList("order", items).Render(context.Background(), b); err != nil {
+		t.Fatalf("failed to render: %v", err)
+	}
+	html, _, ok := strings.Cut(b.String(), "<script")
+	if !ok {
+		t.Error("expected the drag and drop script to be rendered")
+	}
+	expected := `<ul class="drag-drop-list" role="listbox" aria-label="Reorder items" data-drag-drop-list>` +
+		`<li role="option" draggable="true" tabindex="0" aria-grabbed="false" aria-selected="false">First<input type="hidden" name="order" value="1"></li>` +
+		`<li role="option" draggable="true" tabindex="0" aria-grabbed="false" aria-selected="false">&lt;Second&gt;<input type="hidden" name="order" value="2"></li>` +
+		`</ul>`
+	if diff := cmp.Diff(expected, html); diff != "" {
+		t.Error(diff)
+	}
+}
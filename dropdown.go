@@ -0,0 +1,46 @@
+package templ
+
+import (
+	"context"
+	"io"
+)
+
+// NewDropdown renders a button containing the trigger, which shows or hides the content.
+// The content is hidden when the Escape key is pressed, or when the user clicks outside of
+// the dropdown. The id is used as the id of the content element.
+func NewDropdown(id string, trigger, content Component) Component {
+	return ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		contentID := EscapeString(id)
+		if err = writeStrings(w,
+			`<div class="dropdown" data-dropdown>`,
+			`<button type="button" aria-haspopup="true" aria-expanded="false" aria-controls="`, contentID, `">`); err != nil {
+			return err
+		}
+		if err = trigger.Render(ctx, w); err != nil {
+			return err
+		}
+		if err = writeStrings(w, `</button><div id="`, contentID, `" class="dropdown-content" hidden>`); err != nil {
+			return err
+		}
+		if err = content.Render(ctx, w); err != nil {
+			return err
+		}
+		if _, err = io.WriteString(w, `</div></div>`); err != nil {
+			return err
+		}
+		return dropdownScript.Render(ctx, w)
+	})
+}
+
+var dropdownScript = ComponentScript{
+	Name: `__templ_dropdown`,
+	Function: `(function(){` +
+		`function toggle(button,open){button.setAttribute("aria-expanded",String(open));document.getElementById(button.getAttribute("aria-controls")).hidden=!open;}` +
+		`function closeAll(except){document.querySelectorAll("[data-dropdown] > button[aria-expanded=true]").forEach(function(b){if(b!==except){toggle(b,false);}});}` +
+		`document.addEventListener("click",function(e){var button=e.target.closest&&e.target.closest("[data-dropdown] > button");` +
+		`if(button){closeAll(button);toggle(button,button.getAttribute("aria-expanded")!=="true");return;}` +
+		`if(!(e.target.closest&&e.target.closest("[data-dropdown]"))){closeAll(null);}});` +
+		`document.addEventListener("keydown",function(e){if(e.key!=="Escape"){return;}var d=e.target.closest&&e.target.closest("[data-dropdown]");` +
+		`closeAll(null);if(d){d.querySelector("button").focus();}});` +
+		`})();`,
+}
@@ -0,0 +1,28 @@
+package templ_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDropdown(t *testing.T) {
+	b := new(bytes.Buffer)
+	if err := templ.NewDropdown("menu", templ.Raw("Options"), templ.Raw("<ul><li>One</li></ul>")).Render(context.Background(), b); err != nil {
+		t.Fatalf("failed to render: %v", err)
+	}
+	html, _, ok := strings.Cut(b.String(), "<script")
+	if !ok {
+		t.Error("expected the dropdown script to be rendered")
+	}
+	expected := `<div class="dropdown" data-dropdown>` +
+		`<button type="button" aria-haspopup="true" aria-expanded="false" aria-controls="menu">Options</button>` +
+		`<div id="menu" class="dropdown-content" hidden><ul><li>One</li></ul></div></div>`
+	if diff := cmp.Diff(expected, html); diff != "" {
+		t.Error(diff)
+	}
+}
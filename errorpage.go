@@ -0,0 +1,73 @@
+package templ
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+)
+
+// ErrorSuggestion is a link suggested to users on an error page.
+type ErrorSuggestion struct {
+	Label string
+	URL   SafeURL
+}
+
+var errorPageClass = newComponentCSSRules("errorPage",
+	`&{max-width:40em;margin:4em auto;padding:0 1em;text-align:center;}`+
+		`& h1{font-size:2em;}`+
+		`& .error-code{display:block;font-size:3em;font-weight:bold;color:#6b7280;}`+
+		`& ul{list-style:none;padding:0;display:flex;flex-wrap:wrap;justify-content:center;gap:1em;}`)
+
+// errorPageMessages are the default messages used when the message is empty.
+var errorPageMessages = map[int]string{
+	http.StatusBadRequest:          "The request could not be understood.",
+	http.StatusUnauthorized:        "You need to sign in to view this page.",
+	http.StatusForbidden:           "You don't have permission to view this page.",
+	http.StatusNotFound:            "The page you're looking for doesn't exist, or has been moved.",
+	http.StatusTooManyRequests:     "You've made too many requests. Please wait a moment and try again.",
+	http.StatusInternalServerError: "Something went wrong. Please try again later.",
+	http.StatusServiceUnavailable:  "The service is temporarily unavailable. Please try again later.",
+}
+
+// NewErrorPage renders an error page, with the status code and status text in the heading,
+// followed by the message, and a list of suggested links. If the message is empty, a default
+// message for the status code is used.
+func NewErrorPage(code int, message string, suggestions []ErrorSuggestion) Component {
+	if message == "" {
+		message = errorPageMessages[code]
+	}
+	if message == "" && code >= 500 {
+		message = errorPageMessages[http.StatusInternalServerError]
+	}
+	return ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		if err = RenderCSSItems(ctx, w, errorPageClass); err != nil {
+			return err
+		}
+		if err = writeStrings(w,
+			`<main class="error-page `, errorPageClass.ID, `">`,
+			`<h1><span class="error-code">`, strconv.Itoa(code), `</span> `, EscapeString(http.StatusText(code)), `</h1>`); err != nil {
+			return err
+		}
+		if message != "" {
+			if err = writeStrings(w, `<p>`, EscapeString(message), `</p>`); err != nil {
+				return err
+			}
+		}
+		if len(suggestions) > 0 {
+			if _, err = io.WriteString(w, `<nav aria-label="Suggestions"><ul>`); err != nil {
+				return err
+			}
+			for _, s := range suggestions {
+				if err = writeStrings(w, `<li><a href="`, EscapeString(string(s.URL)), `">`, EscapeString(s.Label), `</a></li>`); err != nil {
+					return err
+				}
+			}
+			if _, err = io.WriteString(w, `</ul></nav>`); err != nil {
+				return err
+			}
+		}
+		_, err = io.WriteString(w, `</main>`)
+		return err
+	})
+}
@@ -0,0 +1,64 @@
+package templ_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/google/go-cmp/cmp"
+)
+
+var errorPageClassPattern = regexp.MustCompile(`errorPage_[0-9a-f]{4}`)
+
+func TestErrorPage(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    templ.Component
+		expected string
+	}{
+		{
+			name: "with a message and suggestions",
+			input: templ.NewErrorPage(http.StatusNotFound, "No post called <hello>.", []templ.ErrorSuggestion{
+				{Label: "Home", URL: templ.URL("/")},
+				{Label: "Search", URL: templ.URL("/search?q=hello&page=1")},
+			}),
+			expected: `<main class="error-page errorPage"><h1><span class="error-code">404</span> Not Found</h1>` +
+				`<p>No post called &lt;hello&gt;.</p>` +
+				`<nav aria-label="Suggestions"><ul><li><a href="/">Home</a></li><li><a href="/search?q=hello&amp;page=1">Search</a></li></ul></nav>` +
+				`</main>`,
+		},
+		{
+			name:  "default messages are used for known status codes",
+			input: templ.NewErrorPage(http.StatusForbidden, "", nil),
+			expected: `<main class="error-page errorPage"><h1><span class="error-code">403</span> Forbidden</h1>` +
+				`<p>You don&#39;t have permission to view this page.</p></main>`,
+		},
+		{
+			name:  "server errors have a default message",
+			input: templ.NewErrorPage(http.StatusGatewayTimeout, "", nil),
+			expected: `<main class="error-page errorPage"><h1><span class="error-code">504</span> Gateway Timeout</h1>` +
+				`<p>Something went wrong. Please try again later.</p></main>`,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			b := new(bytes.Buffer)
+			if err := tt.input.Render(context.Background(), b); err != nil {
+				t.Fatalf("failed to render: %v", err)
+			}
+			output := errorPageClassPattern.ReplaceAllString(b.String(), "errorPage")
+			style, html, ok := strings.Cut(output, "</style>")
+			if !ok || !strings.Contains(style, ".errorPage .error-code") {
+				t.Errorf("expected the CSS to be rendered, got %q", style)
+			}
+			if diff := cmp.Diff(tt.expected, html); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
@@ -14,6 +14,7 @@ import "time"
 
 func headerTemplate(name string) templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, templ_7745c5c3_W io.Writer) (templ_7745c5c3_Err error) {
+		defer func() { templ_7745c5c3_Err = templ.WrapComponentError(`headerTemplate`, templ_7745c5c3_Err) }()
 		templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templ_7745c5c3_W.(*bytes.Buffer)
 		if !templ_7745c5c3_IsBuffer {
 			templ_7745c5c3_Buffer = templ.GetBuffer()
@@ -51,6 +52,7 @@ func headerTemplate(name string) templ.Component {
 
 func footerTemplate() templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, templ_7745c5c3_W io.Writer) (templ_7745c5c3_Err error) {
+		defer func() { templ_7745c5c3_Err = templ.WrapComponentError(`footerTemplate`, templ_7745c5c3_Err) }()
 		templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templ_7745c5c3_W.(*bytes.Buffer)
 		if !templ_7745c5c3_IsBuffer {
 			templ_7745c5c3_Buffer = templ.GetBuffer()
@@ -88,6 +90,7 @@ func footerTemplate() templ.Component {
 
 func navTemplate() templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, templ_7745c5c3_W io.Writer) (templ_7745c5c3_Err error) {
+		defer func() { templ_7745c5c3_Err = templ.WrapComponentError(`navTemplate`, templ_7745c5c3_Err) }()
 		templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templ_7745c5c3_W.(*bytes.Buffer)
 		if !templ_7745c5c3_IsBuffer {
 			templ_7745c5c3_Buffer = templ.GetBuffer()
@@ -112,6 +115,7 @@ func navTemplate() templ.Component {
 
 func layout(name string) templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, templ_7745c5c3_W io.Writer) (templ_7745c5c3_Err error) {
+		defer func() { templ_7745c5c3_Err = templ.WrapComponentError(`layout`, templ_7745c5c3_Err) }()
 		templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templ_7745c5c3_W.(*bytes.Buffer)
 		if !templ_7745c5c3_IsBuffer {
 			templ_7745c5c3_Buffer = templ.GetBuffer()
@@ -177,6 +181,7 @@ func layout(name string) templ.Component {
 
 func postsTemplate(posts []Post) templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, templ_7745c5c3_W io.Writer) (templ_7745c5c3_Err error) {
+		defer func() { templ_7745c5c3_Err = templ.WrapComponentError(`postsTemplate`, templ_7745c5c3_Err) }()
 		templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templ_7745c5c3_W.(*bytes.Buffer)
 		if !templ_7745c5c3_IsBuffer {
 			templ_7745c5c3_Buffer = templ.GetBuffer()
@@ -237,6 +242,7 @@ func postsTemplate(posts []Post) templ.Component {
 
 func home() templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, templ_7745c5c3_W io.Writer) (templ_7745c5c3_Err error) {
+		defer func() { templ_7745c5c3_Err = templ.WrapComponentError(`home`, templ_7745c5c3_Err) }()
 		templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templ_7745c5c3_W.(*bytes.Buffer)
 		if !templ_7745c5c3_IsBuffer {
 			templ_7745c5c3_Buffer = templ.GetBuffer()
@@ -276,6 +282,7 @@ func home() templ.Component {
 
 func posts(posts []Post) templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, templ_7745c5c3_W io.Writer) (templ_7745c5c3_Err error) {
+		defer func() { templ_7745c5c3_Err = templ.WrapComponentError(`posts`, templ_7745c5c3_Err) }()
 		templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templ_7745c5c3_W.(*bytes.Buffer)
 		if !templ_7745c5c3_IsBuffer {
 			templ_7745c5c3_Buffer = templ.GetBuffer()
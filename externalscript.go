@@ -0,0 +1,73 @@
+package templ
+
+import (
+	"context"
+	"crypto/sha512"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"regexp"
+)
+
+// ExternalScript is a script loaded from a URL, e.g. from a CDN.
+type ExternalScript struct {
+	Src SafeURL
+	// SRIHash is the Subresource Integrity hash of the script, e.g. "sha384-...", which
+	// can be calculated with ComputeSRI.
+	SRIHash string
+	Async   bool
+	Defer   bool
+}
+
+var sriHash = regexp.MustCompile(`^sha(?:256|384|512)-[A-Za-z0-9+/]+={0,2}(?: sha(?:256|384|512)-[A-Za-z0-9+/]+={0,2})*$`)
+
+// ComputeSRI returns the sha384 Subresource Integrity hash of the content, for use in the
+// integrity attribute of scripts and stylesheets.
+func ComputeSRI(content []byte) string {
+	sum := sha512.Sum384(content)
+	return "sha384-" + base64.StdEncoding.EncodeToString(sum[:])
+}
+
+// RenderExternalScript renders a <script> element that loads the script. The src is
+// sanitized using URL. If the SRIHash is set, the integrity and crossorigin attributes
+// are added, so that the browser refuses to run the script if it has been modified.
+//
+// Each script is rendered once per context, in the same way as ComponentScript.
+func RenderExternalScript(ctx context.Context, w io.Writer, s ExternalScript) (err error) {
+	if s.SRIHash != "" && !sriHash.MatchString(s.SRIHash) {
+		return fmt.Errorf("templ: invalid SRI hash %q for script %q", s.SRIHash, s.Src)
+	}
+	src := string(URL(string(s.Src)))
+	_, v := getContext(ctx)
+	if v.hasScriptBeenRendered("src:" + src) {
+		return nil
+	}
+	if err = writeStrings(w, `<script src="`, EscapeString(src), `"`); err != nil {
+		return err
+	}
+	if err = writeIntegrity(w, s.SRIHash); err != nil {
+		return err
+	}
+	if s.Async {
+		if _, err = io.WriteString(w, ` async`); err != nil {
+			return err
+		}
+	}
+	if s.Defer {
+		if _, err = io.WriteString(w, ` defer`); err != nil {
+			return err
+		}
+	}
+	if _, err = io.WriteString(w, `></script>`); err != nil {
+		return err
+	}
+	v.addScript("src:" + src)
+	return nil
+}
+
+func writeIntegrity(w io.Writer, hash string) error {
+	if hash == "" {
+		return nil
+	}
+	return writeStrings(w, ` integrity="`, hash, `" crossorigin="anonymous"`)
+}
@@ -0,0 +1,75 @@
+package templ_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestComputeSRI(t *testing.T) {
+	// echo -n "alert('Hello, world.');" | openssl dgst -sha384 -binary | openssl base64 -A
+	expected := "sha384-H8BRh8j48O9oYatfu5AZzq6A9RINhZO5H16dQZngK7T62em8MUt1FLm52t+eX6xO"
+	if diff := cmp.Diff(expected, templ.ComputeSRI([]byte("alert('Hello, world.');"))); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestRenderExternalScript(t *testing.T) {
+	tests := []struct {
+		name          string
+		input         templ.ExternalScript
+		expected      string
+		expectedError bool
+	}{
+		{
+			name:     "scripts without a hash",
+			input:    templ.ExternalScript{Src: "/app.js", Defer: true},
+			expected: `<script src="/app.js" defer></script>`,
+		},
+		{
+			name:     "scripts with a hash",
+			input:    templ.ExternalScript{Src: "https://cdn.example.com/lib.js?v=1&min=true", SRIHash: "sha384-abc+/=", Async: true},
+			expected: `<script src="https://cdn.example.com/lib.js?v=1&amp;min=true" integrity="sha384-abc+/=" crossorigin="anonymous" async></script>`,
+		},
+		{
+			name:     "unsafe URLs are sanitized",
+			input:    templ.ExternalScript{Src: "javascript:alert(1)"},
+			expected: `<script src="about:invalid#TemplFailedSanitizationURL"></script>`,
+		},
+		{
+			name:          "invalid hashes are rejected",
+			input:         templ.ExternalScript{Src: "/app.js", SRIHash: `md5-abc" onload="alert(1)`},
+			expectedError: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := templ.InitializeContext(context.Background())
+			b := new(bytes.Buffer)
+			err := templ.RenderExternalScript(ctx, b, tt.input)
+			if tt.expectedError {
+				if err == nil {
+					t.Error("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed to render: %v", err)
+			}
+			if diff := cmp.Diff(tt.expected, b.String()); diff != "" {
+				t.Error(diff)
+			}
+			b.Reset()
+			if err = templ.RenderExternalScript(ctx, b, tt.input); err != nil {
+				t.Fatalf("failed to render: %v", err)
+			}
+			if b.Len() != 0 {
+				t.Errorf("expected the script to be rendered once, got %q", b.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package templ
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/a-h/templ/safehtml"
+)
+
+// ExternalStylesheet is a stylesheet loaded from a URL, e.g. from a CDN.
+type ExternalStylesheet struct {
+	Href SafeURL
+	// SRIHash is the Subresource Integrity hash of the stylesheet, e.g. "sha384-...", which
+	// can be calculated with ComputeSRI.
+	SRIHash string
+	// Media is the media query that the stylesheet applies to, e.g. "print".
+	Media string
+}
+
+// RenderExternalStylesheet renders a <link rel="stylesheet"> element that loads the
+// stylesheet. The href is sanitized using URL. If the SRIHash is set, the integrity and
+// crossorigin attributes are added.
+//
+// The media may only contain the all, screen and print media types, and known media
+// features, in the same way as NewMediaCSSClass.
+//
+// Each stylesheet is rendered once per context.
+func RenderExternalStylesheet(ctx context.Context, w io.Writer, s ExternalStylesheet) (err error) {
+	if s.SRIHash != "" && !sriHash.MatchString(s.SRIHash) {
+		return fmt.Errorf("templ: invalid SRI hash %q for stylesheet %q", s.SRIHash, s.Href)
+	}
+	if s.Media != "" && safehtml.SanitizeMediaQuery(s.Media) == safehtml.InnocuousMediaQuery {
+		return fmt.Errorf("templ: invalid media %q for stylesheet %q", s.Media, s.Href)
+	}
+	href := string(URL(string(s.Href)))
+	_, v := getContext(ctx)
+	if v.hasClassBeenRendered("href:" + href) {
+		return nil
+	}
+	if err = writeStrings(w, `<link rel="stylesheet" href="`, EscapeString(href), `"`); err != nil {
+		return err
+	}
+	if err = writeIntegrity(w, s.SRIHash); err != nil {
+		return err
+	}
+	if s.Media != "" {
+		if err = writeStrings(w, ` media="`, EscapeString(s.Media), `"`); err != nil {
+			return err
+		}
+	}
+	if _, err = io.WriteString(w, `>`); err != nil {
+		return err
+	}
+	v.addClass("href:" + href)
+	return nil
+}
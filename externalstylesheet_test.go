@@ -0,0 +1,77 @@
+package templ_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRenderExternalStylesheet(t *testing.T) {
+	tests := []struct {
+		name          string
+		input         templ.ExternalStylesheet
+		expected      string
+		expectedError bool
+	}{
+		{
+			name:     "stylesheets without a hash",
+			input:    templ.ExternalStylesheet{Href: "/app.css"},
+			expected: `<link rel="stylesheet" href="/app.css">`,
+		},
+		{
+			name:     "stylesheets with a hash and media",
+			input:    templ.ExternalStylesheet{Href: "https://cdn.example.com/print.css", SRIHash: "sha384-abc", Media: "print"},
+			expected: `<link rel="stylesheet" href="https://cdn.example.com/print.css" integrity="sha384-abc" crossorigin="anonymous" media="print">`,
+		},
+		{
+			name:     "media queries are allowed",
+			input:    templ.ExternalStylesheet{Href: "/wide.css", Media: "screen and (min-width: 600px)"},
+			expected: `<link rel="stylesheet" href="/wide.css" media="screen and (min-width: 600px)">`,
+		},
+		{
+			name:     "unsafe URLs are sanitized",
+			input:    templ.ExternalStylesheet{Href: "javascript:alert(1)"},
+			expected: `<link rel="stylesheet" href="about:invalid#TemplFailedSanitizationURL">`,
+		},
+		{
+			name:          "unknown media types are rejected",
+			input:         templ.ExternalStylesheet{Href: "/app.css", Media: `tv" onload="alert(1)`},
+			expectedError: true,
+		},
+		{
+			name:          "invalid hashes are rejected",
+			input:         templ.ExternalStylesheet{Href: "/app.css", SRIHash: "abc"},
+			expectedError: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := templ.InitializeContext(context.Background())
+			b := new(bytes.Buffer)
+			err := templ.RenderExternalStylesheet(ctx, b, tt.input)
+			if tt.expectedError {
+				if err == nil {
+					t.Error("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed to render: %v", err)
+			}
+			if diff := cmp.Diff(tt.expected, b.String()); diff != "" {
+				t.Error(diff)
+			}
+			b.Reset()
+			if err = templ.RenderExternalStylesheet(ctx, b, tt.input); err != nil {
+				t.Fatalf("failed to render: %v", err)
+			}
+			if b.Len() != 0 {
+				t.Errorf("expected the stylesheet to be rendered once, got %q", b.String())
+			}
+		})
+	}
+}
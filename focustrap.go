@@ -0,0 +1,43 @@
+package templ
+
+import (
+	"context"
+	"io"
+	"strconv"
+)
+
+// NewFocusTrap renders the body between two focusable sentinel elements. While the trap is
+// active, tabbing past the last focusable element of the body moves focus to the first, and
+// vice versa. The trap can be toggled in the browser by setting the data-focus-trap-active
+// attribute of the element with the given id to "true" or "false".
+func NewFocusTrap(id string, active bool, body Component) Component {
+	return ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		if err = writeStrings(w,
+			`<div id="`, EscapeString(id), `" data-focus-trap data-focus-trap-active="`, strconv.FormatBool(active), `">`,
+			`<span tabindex="0" data-focus-trap-start></span>`); err != nil {
+			return err
+		}
+		if err = body.Render(ctx, w); err != nil {
+			return err
+		}
+		if _, err = io.WriteString(w, `<span tabindex="0" data-focus-trap-end></span></div>`); err != nil {
+			return err
+		}
+		return focusTrapScript.Render(ctx, w)
+	})
+}
+
+var focusTrapScript = ComponentScript{
+	Name: `__templ_focusTrap`,
+	Function: `(function(){` +
+		`var selector="a[href],area[href],button:not([disabled]),input:not([disabled]),select:not([disabled]),textarea:not([disabled]),iframe,[contenteditable],[tabindex]:not([tabindex='-1']):not([data-focus-trap-start]):not([data-focus-trap-end])";` +
+		`function focusable(trap){return Array.prototype.filter.call(trap.querySelectorAll(selector),function(el){return el.offsetParent!==null||el===document.activeElement;});}` +
+		`document.addEventListener("focusin",function(e){` +
+		`var sentinel=e.target;var start=sentinel.hasAttribute&&sentinel.hasAttribute("data-focus-trap-start");var end=sentinel.hasAttribute&&sentinel.hasAttribute("data-focus-trap-end");` +
+		`if(!start&&!end){return;}var trap=sentinel.closest("[data-focus-trap]");` +
+		`if(!trap||trap.getAttribute("data-focus-trap-active")!=="true"){return;}` +
+		`var els=focusable(trap);if(!els.length){return;}` +
+		`(start?els[els.length-1]:els[0]).focus();` +
+		`});` +
+		`})();`,
+}
@@ -0,0 +1,49 @@
+package templ_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestFocusTrap(t *testing.T) {
+	body := templ.Raw(`<button>OK</button>`)
+	tests := []struct {
+		name     string
+		active   bool
+		expected string
+	}{
+		{
+			name:   "active",
+			active: true,
+			expected: `<div id="dialog" data-focus-trap data-focus-trap-active="true">` +
+				`<span tabindex="0" data-focus-trap-start></span><button>OK</button><span tabindex="0" data-focus-trap-end></span></div>`,
+		},
+		{
+			name:   "inactive",
+			active: false,
+			expected: `<div id="dialog" data-focus-trap data-focus-trap-active="false">` +
+				`<span tabindex="0" data-focus-trap-start></span><button>OK</button><span tabindex="0" data-focus-trap-end></span></div>`,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			b := new(bytes.Buffer)
+			if err := templ.NewFocusTrap("dialog", tt.active, body).Render(context.Background(), b); err != nil {
+				t.Fatalf("failed to render: %v", err)
+			}
+			html, script, _ := strings.Cut(b.String(), "<script")
+			if diff := cmp.Diff(tt.expected, html); diff != "" {
+				t.Error(diff)
+			}
+			if !strings.Contains(script, "focusin") {
+				t.Errorf("expected the script to be rendered, got %q", script)
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package templ
+
+import (
+	"context"
+	"io"
+)
+
+// CookieCategory is a category of cookies that users can consent to, e.g. analytics.
+type CookieCategory struct {
+	// Name is stored in the consent cookie, and must be unique.
+	Name        string
+	Label       string
+	Description string
+	// Required categories are always enabled, and can't be rejected.
+	Required bool
+}
+
+// GDPRConsentCookieName is the name of the cookie that stores the user's consent, as a
+// URL encoded JSON object of category names to booleans.
+const GDPRConsentCookieName = "templ_consent"
+
+// NewGDPRBanner renders a cookie consent banner, with buttons to accept or reject all
+// optional cookies, or to choose which categories to accept.
+//
+// The choice is stored in the GDPRConsentCookieName cookie for a year, and a
+// templ:consent event is dispatched on the document, with the choice as the detail. The
+// banner hides itself if the cookie is already set.
+func NewGDPRBanner(policyURL SafeURL, categories []CookieCategory) Component {
+	return ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		if err = writeStrings(w,
+			`<section class="gdpr-banner" role="region" aria-label="Cookie consent" data-gdpr-banner>`,
+			`<p>We use cookies to improve your experience. <a href="`, EscapeString(string(policyURL)), `">Cookie policy</a></p>`,
+			`<fieldset id="gdpr-banner-categories" hidden><legend>Cookie preferences</legend>`); err != nil {
+			return err
+		}
+		for _, c := range categories {
+			name := EscapeString(c.Name)
+			if err = writeStrings(w, `<div><label><input type="checkbox" name="`, name, `" value="true" data-gdpr-category="`, name, `"`); err != nil {
+				return err
+			}
+			if c.Required {
+				if _, err = io.WriteString(w, ` checked disabled`); err != nil {
+					return err
+				}
+			}
+			if err = writeStrings(w, `> `, EscapeString(c.Label), `</label>`); err != nil {
+				return err
+			}
+			if c.Description != "" {
+				if err = writeStrings(w, `<p>`, EscapeString(c.Description), `</p>`); err != nil {
+					return err
+				}
+			}
+			if _, err = io.WriteString(w, `</div>`); err != nil {
+				return err
+			}
+		}
+		if err = writeStrings(w,
+			`</fieldset>`,
+			`<div class="gdpr-banner-actions">`,
+			`<button type="button" data-gdpr-action="accept">Accept all</button>`,
+			`<button type="button" data-gdpr-action="reject">Reject all</button>`,
+			`<button type="button" data-gdpr-action="manage" aria-expanded="false" aria-controls="gdpr-banner-categories">Manage preferences</button>`,
+			`<button type="button" data-gdpr-action="save" hidden>Save preferences</button>`,
+			`</div></section>`); err != nil {
+			return err
+		}
+		return gdprBannerScript.Render(ctx, w)
+	})
+}
+
+var gdprBannerScript = ComponentScript{
+	Name: `__templ_gdprBanner`,
+	Function: `(function(){` +
+		`var name="` + GDPRConsentCookieName + `=";` +
+		`var consented=document.cookie.split("; ").some(function(c){return c.indexOf(name)===0;});` +
+		`document.querySelectorAll("[data-gdpr-banner]").forEach(function(banner){if(consented){banner.hidden=true;}});` +
+		`document.addEventListener("click",function(e){` +
+		`var button=e.target.closest&&e.target.closest("[data-gdpr-banner] [data-gdpr-action]");if(!button){return;}` +
+		`var banner=button.closest("[data-gdpr-banner]");var action=button.getAttribute("data-gdpr-action");` +
+		`var boxes=banner.querySelectorAll("[data-gdpr-category]");` +
+		`if(action==="manage"){var open=button.getAttribute("aria-expanded")!=="true";button.setAttribute("aria-expanded",String(open));` +
+		`document.getElementById(button.getAttribute("aria-controls")).hidden=!open;banner.querySelector("[data-gdpr-action=save]").hidden=!open;return;}` +
+		`var consent={};boxes.forEach(function(b){consent[b.getAttribute("data-gdpr-category")]=b.disabled||action==="accept"||(action==="save"&&b.checked);});` +
+		`document.cookie=name+encodeURIComponent(JSON.stringify(consent))+"; max-age=31536000; path=/; SameSite=Lax";` +
+		`banner.hidden=true;document.dispatchEvent(new CustomEvent("templ:consent",{detail:consent}));` +
+		`});` +
+		`})();`,
+}
@@ -0,0 +1,55 @@
+package templ_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestGDPRBanner(t *testing.T) {
+	tests := []struct {
+		name       string
+		categories []templ.CookieCategory
+		expected   string
+	}{
+		{
+			name: "required categories can't be unchecked",
+			categories: []templ.CookieCategory{
+				{Name: "necessary", Label: "Necessary", Description: "Required for the site to work.", Required: true},
+				{Name: "analytics", Label: "Analytics & <statistics>"},
+			},
+			expected: `<section class="gdpr-banner" role="region" aria-label="Cookie consent" data-gdpr-banner>` +
+				`<p>We use cookies to improve your experience. <a href="/cookies">Cookie policy</a></p>` +
+				`<fieldset id="gdpr-banner-categories" hidden><legend>Cookie preferences</legend>` +
+				`<div><label><input type="checkbox" name="necessary" value="true" data-gdpr-category="necessary" checked disabled> Necessary</label><p>Required for the site to work.</p></div>` +
+				`<div><label><input type="checkbox" name="analytics" value="true" data-gdpr-category="analytics"> Analytics &amp; &lt;statistics&gt;</label></div>` +
+				`</fieldset>` +
+				`<div class="gdpr-banner-actions">` +
+				`<button type="button" data-gdpr-action="accept">Accept all</button>` +
+				`<button type="button" data-gdpr-action="reject">Reject all</button>` +
+				`<button type="button" data-gdpr-action="manage" aria-expanded="false" aria-controls="gdpr-banner-categories">Manage preferences</button>` +
+				`<button type="button" data-gdpr-action="save" hidden>Save preferences</button>` +
+				`</div></section>`,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			b := new(bytes.Buffer)
+			if err := templ.NewGDPRBanner("/cookies", tt.categories).Render(context.Background(), b); err != nil {
+				t.Fatalf("failed to render: %v", err)
+			}
+			actual, script, _ := strings.Cut(b.String(), "<script")
+			if diff := cmp.Diff(tt.expected, actual); diff != "" {
+				t.Error(diff)
+			}
+			if !strings.Contains(script, templ.GDPRConsentCookieName) {
+				t.Errorf("expected the script to use the consent cookie, got %q", script)
+			}
+		})
+	}
+}
@@ -82,6 +82,8 @@ type generator struct {
 	sourceMap   *parser.SourceMap
 	variableID  int
 	childrenVar string
+	// templateName of the template being written, used to identify the component in errors.
+	templateName string
 
 	// version of templ.
 	version string
@@ -281,7 +283,7 @@ func (g *generator) writeCSS(n parser.CSSTemplate) error {
 				return fmt.Errorf("unknown CSS property type: %v", reflect.TypeOf(p))
 			}
 		}
-		if _, err = g.w.WriteIndent(indentLevel, fmt.Sprintf("templ_7745c5c3_CSSID := templ.CSSID(`%s`, templ_7745c5c3_CSSBuilder.String())\n", n.Name)); err != nil {
+		if _, err = g.w.WriteIndent(indentLevel, fmt.Sprintf("templ_7745c5c3_CSSID := templ.CSSIDWithLength(`%s`, templ_7745c5c3_CSSBuilder.String(), templ.DefaultCSSIDLength)\n", n.Name)); err != nil {
 			return err
 		}
 		// return templ.CSS {
@@ -384,6 +386,10 @@ func (g *generator) writeTemplate(nodeIdx int, t parser.HTMLTemplate) error {
 	}
 	{
 		indentLevel++
+		g.templateName = templateName(t.Expression.Value)
+		if err = g.writeComponentErrorWrapper(indentLevel); err != nil {
+			return err
+		}
 		if err := g.writeTemplBuffer(indentLevel); err != nil {
 			return err
 		}
@@ -461,6 +467,40 @@ func (g *generator) writeTemplate(nodeIdx int, t parser.HTMLTemplate) error {
 	return nil
 }
 
+// writeComponentErrorWrapper wraps errors returned by the component in a templ.ComponentError,
+// so that the component that failed can be identified.
+func (g *generator) writeComponentErrorWrapper(indentLevel int) (err error) {
+	// defer func() { templ_7745c5c3_Err = templ.WrapComponentError(`Name`, templ_7745c5c3_Err) }()
+	_, err = g.w.WriteIndent(indentLevel, "defer func() { templ_7745c5c3_Err = templ.WrapComponentError("+createGoString(g.templateName)+", templ_7745c5c3_Err) }()\n")
+	return err
+}
+
+// templateName returns the name of a template from its declaration, e.g. `Name` from
+// `Name(a string)`, or `Receiver.Name` from `(r *Receiver) Name(a string)`.
+func templateName(decl string) string {
+	decl = strings.TrimSpace(decl)
+	var receiver string
+	if strings.HasPrefix(decl, "(") {
+		if i := strings.Index(decl, ")"); i >= 0 {
+			fields := strings.Fields(decl[1:i])
+			if len(fields) > 0 {
+				receiver = strings.TrimPrefix(fields[len(fields)-1], "*")
+				if j := strings.Index(receiver, "["); j >= 0 {
+					receiver = receiver[:j]
+				}
+			}
+			decl = strings.TrimSpace(decl[i+1:])
+		}
+	}
+	if i := strings.IndexAny(decl, "[("); i >= 0 {
+		decl = strings.TrimSpace(decl[:i])
+	}
+	if receiver != "" {
+		return receiver + "." + decl
+	}
+	return decl
+}
+
 func stripWhitespace(input []parser.Node) (output []parser.Node) {
 	for i, n := range input {
 		if _, isWhiteSpace := n.(parser.Whitespace); !isWhiteSpace {
@@ -727,6 +767,8 @@ func (g *generator) writeBlockTemplElementExpression(indentLevel int, n parser.T
 		return err
 	}
 	indentLevel++
+	// Errors in the children are wrapped by the template that contains them, so aren't
+	// wrapped here too.
 	if err := g.writeTemplBuffer(indentLevel); err != nil {
 		return err
 	}
@@ -47,3 +47,25 @@ templ h1() {
 		t.Fatalf("failed to write Go expression: %v", err)
 	}
 }
+
+func TestTemplateName(t *testing.T) {
+	tests := []struct {
+		decl     string
+		expected string
+	}{
+		{decl: "Name()", expected: "Name"},
+		{decl: "Name(a string, b int)", expected: "Name"},
+		{decl: "Name[T any](v T)", expected: "Name"},
+		{decl: "(r Receiver) Name()", expected: "Receiver.Name"},
+		{decl: "(r *Receiver) Name(a string)", expected: "Receiver.Name"},
+		{decl: "(r *Receiver[T]) Name()", expected: "Receiver.Name"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.decl, func(t *testing.T) {
+			if actual := templateName(tt.decl); actual != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package testcomponenterrors
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/google/go-cmp/cmp"
+)
+
+func Test(t *testing.T) {
+	t.Run("components that render successfully do not return errors", func(t *testing.T) {
+		err := Page{}.Render().Render(context.Background(), &bytes.Buffer{})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+	t.Run("errors identify the component that failed, and its ancestors", func(t *testing.T) {
+		errSomethingBad := errors.New("bad error")
+
+		err := Page{Err: errSomethingBad}.Render().Render(context.Background(), &bytes.Buffer{})
+		if !templ.IsComponentError(err) {
+			t.Fatalf("expected a templ.ComponentError, got %T", err)
+		}
+		ce := templ.UnwrapComponentError(err)
+		if ce.Name != "Leaf" {
+			t.Errorf("expected the failed component to be Leaf, got %q", ce.Name)
+		}
+		// The Leaf is rendered within the children of Layout, which is rendered by the Page.
+		if diff := cmp.Diff([]string{"Page.Render", "Layout"}, ce.Stack); diff != "" {
+			t.Error(diff)
+		}
+		var templateErr templ.Error
+		if !errors.As(err, &templateErr) {
+			t.Errorf("expected the templ.Error to be wrapped, got %T", ce.Err)
+		}
+		if diff := cmp.Diff("templ: failed to render Page.Render > Layout > Leaf: "+templateErr.Error(), err.Error()); diff != "" {
+			t.Error(diff)
+		}
+		if !errors.Is(err, errSomethingBad) {
+			t.Errorf("expected error: %v, but got %v", errSomethingBad, err)
+		}
+	})
+}
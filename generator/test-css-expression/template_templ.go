@@ -12,7 +12,7 @@ func className() templ.CSSClass {
 	templ_7745c5c3_CSSBuilder.WriteString(`background-color:#ffffff;`)
 	templ_7745c5c3_CSSBuilder.WriteString(`max-height:calc(100vh - 170px);`)
 	templ_7745c5c3_CSSBuilder.WriteString(string(templ.SanitizeCSS(`color`, red)))
-	templ_7745c5c3_CSSID := templ.CSSID(`className`, templ_7745c5c3_CSSBuilder.String())
+	templ_7745c5c3_CSSID := templ.CSSIDWithLength(`className`, templ_7745c5c3_CSSBuilder.String(), templ.DefaultCSSIDLength)
 	return templ.ComponentCSSClass{
 		ID:    templ_7745c5c3_CSSID,
 		Class: templ.SafeCSS(`.` + templ_7745c5c3_CSSID + `{` + templ_7745c5c3_CSSBuilder.String() + `}`),
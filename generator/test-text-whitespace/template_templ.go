@@ -11,6 +11,9 @@ import "bytes"
 
 func WhitespaceIsAddedWithinTemplStatements() templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, templ_7745c5c3_W io.Writer) (templ_7745c5c3_Err error) {
+		defer func() {
+			templ_7745c5c3_Err = templ.WrapComponentError(`WhitespaceIsAddedWithinTemplStatements`, templ_7745c5c3_Err)
+		}()
 		templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templ_7745c5c3_W.(*bytes.Buffer)
 		if !templ_7745c5c3_IsBuffer {
 			templ_7745c5c3_Buffer = templ.GetBuffer()
@@ -47,6 +50,9 @@ const WhitespaceIsAddedWithinTemplStatementsExpected = `<p>This is some text. So
 
 func InlineElementsAreNotPadded() templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, templ_7745c5c3_W io.Writer) (templ_7745c5c3_Err error) {
+		defer func() {
+			templ_7745c5c3_Err = templ.WrapComponentError(`InlineElementsAreNotPadded`, templ_7745c5c3_Err)
+		}()
 		templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templ_7745c5c3_W.(*bytes.Buffer)
 		if !templ_7745c5c3_IsBuffer {
 			templ_7745c5c3_Buffer = templ.GetBuffer()
@@ -73,6 +79,9 @@ const InlineElementsAreNotPaddedExpected = `<p>Inline text <b>is spaced properly
 
 func WhiteSpaceInHTMLIsNormalised() templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, templ_7745c5c3_W io.Writer) (templ_7745c5c3_Err error) {
+		defer func() {
+			templ_7745c5c3_Err = templ.WrapComponentError(`WhiteSpaceInHTMLIsNormalised`, templ_7745c5c3_Err)
+		}()
 		templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templ_7745c5c3_W.(*bytes.Buffer)
 		if !templ_7745c5c3_IsBuffer {
 			templ_7745c5c3_Buffer = templ.GetBuffer()
@@ -99,6 +108,7 @@ const WhiteSpaceInHTMLIsNormalisedExpected = `<p>newlines and other whitespace a
 
 func WhiteSpaceAroundValues() templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, templ_7745c5c3_W io.Writer) (templ_7745c5c3_Err error) {
+		defer func() { templ_7745c5c3_Err = templ.WrapComponentError(`WhiteSpaceAroundValues`, templ_7745c5c3_Err) }()
 		templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templ_7745c5c3_W.(*bytes.Buffer)
 		if !templ_7745c5c3_IsBuffer {
 			templ_7745c5c3_Buffer = templ.GetBuffer()
@@ -140,6 +150,9 @@ const WhiteSpaceAroundTemplatedValuesExpected = `<div>templ allows whitespace ar
 
 func WhiteSpaceAroundTemplatedValues(prefix, statement string) templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, templ_7745c5c3_W io.Writer) (templ_7745c5c3_Err error) {
+		defer func() {
+			templ_7745c5c3_Err = templ.WrapComponentError(`WhiteSpaceAroundTemplatedValues`, templ_7745c5c3_Err)
+		}()
 		templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templ_7745c5c3_W.(*bytes.Buffer)
 		if !templ_7745c5c3_IsBuffer {
 			templ_7745c5c3_Buffer = templ.GetBuffer()
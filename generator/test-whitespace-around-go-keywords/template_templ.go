@@ -13,6 +13,9 @@ import "fmt"
 
 func WhitespaceIsConsistentInIf(firstIf, secondIf bool) templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, templ_7745c5c3_W io.Writer) (templ_7745c5c3_Err error) {
+		defer func() {
+			templ_7745c5c3_Err = templ.WrapComponentError(`WhitespaceIsConsistentInIf`, templ_7745c5c3_Err)
+		}()
 		templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templ_7745c5c3_W.(*bytes.Buffer)
 		if !templ_7745c5c3_IsBuffer {
 			templ_7745c5c3_Buffer = templ.GetBuffer()
@@ -61,6 +64,9 @@ const WhitespaceIsConsistentInTrueElseExpected = `<button>Start</button> <button
 
 func WhitespaceIsConsistentInFalseIf() templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, templ_7745c5c3_W io.Writer) (templ_7745c5c3_Err error) {
+		defer func() {
+			templ_7745c5c3_Err = templ.WrapComponentError(`WhitespaceIsConsistentInFalseIf`, templ_7745c5c3_Err)
+		}()
 		templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templ_7745c5c3_W.(*bytes.Buffer)
 		if !templ_7745c5c3_IsBuffer {
 			templ_7745c5c3_Buffer = templ.GetBuffer()
@@ -97,6 +103,9 @@ const WhitespaceIsConsistentInFalseIfExpected = `<button>Start</button> <button>
 
 func WhitespaceIsConsistentInSwitch(i int) templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, templ_7745c5c3_W io.Writer) (templ_7745c5c3_Err error) {
+		defer func() {
+			templ_7745c5c3_Err = templ.WrapComponentError(`WhitespaceIsConsistentInSwitch`, templ_7745c5c3_Err)
+		}()
 		templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templ_7745c5c3_W.(*bytes.Buffer)
 		if !templ_7745c5c3_IsBuffer {
 			templ_7745c5c3_Buffer = templ.GetBuffer()
@@ -140,6 +149,9 @@ const WhitespaceIsConsistentInDefaultSwitchExpected = `<button>Start</button> <b
 
 func WhitespaceIsConsistentInSwitchNoDefault() templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, templ_7745c5c3_W io.Writer) (templ_7745c5c3_Err error) {
+		defer func() {
+			templ_7745c5c3_Err = templ.WrapComponentError(`WhitespaceIsConsistentInSwitchNoDefault`, templ_7745c5c3_Err)
+		}()
 		templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templ_7745c5c3_W.(*bytes.Buffer)
 		if !templ_7745c5c3_IsBuffer {
 			templ_7745c5c3_Buffer = templ.GetBuffer()
@@ -177,6 +189,9 @@ const WhitespaceIsConsistentInSwitchNoDefaultExpected = `<button>Start</button>
 
 func WhitespaceIsConsistentInFor(i int) templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, templ_7745c5c3_W io.Writer) (templ_7745c5c3_Err error) {
+		defer func() {
+			templ_7745c5c3_Err = templ.WrapComponentError(`WhitespaceIsConsistentInFor`, templ_7745c5c3_Err)
+		}()
 		templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templ_7745c5c3_W.(*bytes.Buffer)
 		if !templ_7745c5c3_IsBuffer {
 			templ_7745c5c3_Buffer = templ.GetBuffer()
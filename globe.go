@@ -0,0 +1,125 @@
+package templ
+
+import (
+	"context"
+	"io"
+	"sort"
+	"strings"
+)
+
+// globeCountry is the approximate centroid of a country, in degrees.
+type globeCountry struct {
+	name     string
+	lat, lon float64
+}
+
+// globeCountries are the countries shown on the globe, keyed by ISO 3166-1 alpha-2 code.
+var globeCountries = map[string]globeCountry{
+	"AE": {"United Arab Emirates", 23.4, 53.8},
+	"AR": {"Argentina", -38.4, -63.6},
+	"AT": {"Austria", 47.5, 14.6},
+	"AU": {"Australia", -25.3, 133.8},
+	"BD": {"Bangladesh", 23.7, 90.4},
+	"BE": {"Belgium", 50.5, 4.5},
+	"BR": {"Brazil", -14.2, -51.9},
+	"CA": {"Canada", 56.1, -106.3},
+	"CH": {"Switzerland", 46.8, 8.2},
+	"CL": {"Chile", -35.7, -71.5},
+	"CN": {"China", 35.9, 104.2},
+	"CO": {"Colombia", 4.6, -74.3},
+	"CZ": {"Czechia", 49.8, 15.5},
+	"DE": {"Germany", 51.2, 10.5},
+	"DK": {"Denmark", 56.3, 9.5},
+	"DZ": {"Algeria", 28.0, 1.7},
+	"EG": {"Egypt", 26.8, 30.8},
+	"ES": {"Spain", 40.5, -3.7},
+	"ET": {"Ethiopia", 9.1, 40.5},
+	"FI": {"Finland", 61.9, 25.7},
+	"FR": {"France", 46.2, 2.2},
+	"GB": {"United Kingdom", 55.4, -3.4},
+	"GR": {"Greece", 39.1, 21.8},
+	"ID": {"Indonesia", -0.8, 113.9},
+	"IE": {"Ireland", 53.4, -8.2},
+	"IL": {"Israel", 31.0, 34.9},
+	"IN": {"India", 20.6, 79.0},
+	"IR": {"Iran", 32.4, 53.7},
+	"IT": {"Italy", 41.9, 12.6},
+	"JP": {"Japan", 36.2, 138.3},
+	"KE": {"Kenya", -0.0, 37.9},
+	"KR": {"South Korea", 35.9, 127.8},
+	"MA": {"Morocco", 31.8, -7.1},
+	"MX": {"Mexico", 23.6, -102.6},
+	"MY": {"Malaysia", 4.2, 102.0},
+	"NG": {"Nigeria", 9.1, 8.7},
+	"NL": {"Netherlands", 52.1, 5.3},
+	"NO": {"Norway", 60.5, 8.5},
+	"NZ": {"New Zealand", -40.9, 174.9},
+	"PE": {"Peru", -9.2, -75.0},
+	"PH": {"Philippines", 12.9, 121.8},
+	"PK": {"Pakistan", 30.4, 69.3},
+	"PL": {"Poland", 51.9, 19.1},
+	"PT": {"Portugal", 39.4, -8.2},
+	"RO": {"Romania", 45.9, 25.0},
+	"RU": {"Russia", 61.5, 105.3},
+	"SA": {"Saudi Arabia", 23.9, 45.1},
+	"SE": {"Sweden", 60.1, 18.6},
+	"SG": {"Singapore", 1.4, 103.8},
+	"TH": {"Thailand", 15.9, 101.0},
+	"TR": {"Turkey", 39.0, 35.2},
+	"TW": {"Taiwan", 23.7, 121.0},
+	"UA": {"Ukraine", 48.4, 31.2},
+	"US": {"United States", 37.1, -95.7},
+	"VN": {"Vietnam", 14.1, 108.3},
+	"ZA": {"South Africa", -30.6, 22.9},
+}
+
+var globeClass = newComponentCSSRules("globe",
+	`&{display:block;width:100%;height:auto;background:#eff6ff;}`+
+		`& .globe-grid{fill:none;stroke:#bfdbfe;stroke-width:0.25;}`+
+		`& circle{fill:#94a3b8;}`+
+		`& circle.highlighted{fill:#dc2626;}`)
+
+// NewGlobe renders a simplified SVG world map, using an equirectangular projection, with a
+// marker at the approximate centre of each country. The countries in highlighted, given as
+// ISO 3166-1 alpha-2 codes, e.g. "GB", are highlighted. Unknown codes are ignored.
+func NewGlobe(highlighted []string) Component {
+	return ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		if err = RenderCSSItems(ctx, w, globeClass); err != nil {
+			return err
+		}
+		highlight := make(map[string]bool, len(highlighted))
+		for _, code := range highlighted {
+			highlight[strings.ToUpper(code)] = true
+		}
+		if err = writeStrings(w,
+			`<svg class="globe `, globeClass.ID, `" viewBox="0 0 360 180" role="img" aria-label="World map">`,
+			`<path class="globe-grid" d="M0 90H360M180 0V180M0 30H360M0 60H360M0 120H360M0 150H360M60 0V180M120 0V180M240 0V180M300 0V180"/>`); err != nil {
+			return err
+		}
+		codes := make([]string, 0, len(globeCountries))
+		for code := range globeCountries {
+			codes = append(codes, code)
+		}
+		sort.Strings(codes)
+		for _, code := range codes {
+			c := globeCountries[code]
+			if err = writeStrings(w, `<circle cx="`, formatFloat(c.lon+180), `" cy="`, formatFloat(90-c.lat), `" r="`); err != nil {
+				return err
+			}
+			if highlight[code] {
+				if _, err = io.WriteString(w, `3" class="highlighted`); err != nil {
+					return err
+				}
+			} else {
+				if _, err = io.WriteString(w, `1.5`); err != nil {
+					return err
+				}
+			}
+			if err = writeStrings(w, `" data-country="`, code, `"><title>`, EscapeString(c.name), `</title></circle>`); err != nil {
+				return err
+			}
+		}
+		_, err = io.WriteString(w, `</svg>`)
+		return err
+	})
+}
@@ -0,0 +1,40 @@
+package templ_test
+
+import (
+	"bytes"
+	"context"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/a-h/templ"
+)
+
+var globeClassPattern = regexp.MustCompile(`globe_[0-9a-f]{4}`)
+
+func TestGlobe(t *testing.T) {
+	b := new(bytes.Buffer)
+	if err := templ.NewGlobe([]string{"gb", "US", "XX"}).Render(context.Background(), b); err != nil {
+		t.Fatalf("failed to render: %v", err)
+	}
+	output := globeClassPattern.ReplaceAllString(b.String(), "globe")
+	style, html, ok := strings.Cut(output, "</style>")
+	if !ok || !strings.Contains(style, ".globe circle.highlighted{") {
+		t.Errorf("expected the CSS to be rendered, got %q", style)
+	}
+	if !strings.HasPrefix(html, `<svg class="globe globe" viewBox="0 0 360 180" role="img" aria-label="World map">`) || !strings.HasSuffix(html, `</svg>`) {
+		t.Errorf("expected an SVG, got %q", html)
+	}
+	for _, expected := range []string{
+		`<circle cx="176.6" cy="34.6" r="3" class="highlighted" data-country="GB"><title>United Kingdom</title></circle>`,
+		`<circle cx="84.3" cy="52.9" r="3" class="highlighted" data-country="US"><title>United States</title></circle>`,
+		`<circle cx="190.5" cy="38.8" r="1.5" data-country="DE"><title>Germany</title></circle>`,
+	} {
+		if !strings.Contains(html, expected) {
+			t.Errorf("expected output to contain %q", expected)
+		}
+	}
+	if count := strings.Count(html, `class="highlighted"`); count != 2 {
+		t.Errorf("expected 2 highlighted countries, got %d", count)
+	}
+}
@@ -0,0 +1,50 @@
+package templ
+
+import (
+	"context"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var highlightTag = regexp.MustCompile(`^[a-z][a-z0-9]*$`)
+
+// NewHighlight renders the text, with case-insensitive matches of any of the terms wrapped
+// in a <tag class="highlight"> element. If the tag is empty, or not a valid element name,
+// mark is used. Where terms overlap, the longest term is highlighted.
+func NewHighlight(text string, terms []string, tag string) Component {
+	if !highlightTag.MatchString(tag) {
+		tag = "mark"
+	}
+	patterns := make([]string, 0, len(terms))
+	for _, term := range terms {
+		if term != "" {
+			patterns = append(patterns, regexp.QuoteMeta(term))
+		}
+	}
+	sort.SliceStable(patterns, func(i, j int) bool {
+		return len(patterns[i]) > len(patterns[j])
+	})
+	var re *regexp.Regexp
+	if len(patterns) > 0 {
+		re = regexp.MustCompile(`(?i)` + strings.Join(patterns, "|"))
+	}
+	return ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		if re == nil {
+			_, err = io.WriteString(w, EscapeString(text))
+			return err
+		}
+		var last int
+		for _, m := range re.FindAllStringIndex(text, -1) {
+			if err = writeStrings(w,
+				EscapeString(text[last:m[0]]),
+				`<`, tag, ` class="highlight">`, EscapeString(text[m[0]:m[1]]), `</`, tag, `>`); err != nil {
+				return err
+			}
+			last = m[1]
+		}
+		_, err = io.WriteString(w, EscapeString(text[last:]))
+		return err
+	})
+}
@@ -0,0 +1,71 @@
+package templ_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestHighlight(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		terms    []string
+		tag      string
+		expected string
+	}{
+		{
+			name:     "matches are case-insensitive",
+			text:     "Go is great. go go!",
+			terms:    []string{"go"},
+			expected: `<mark class="highlight">Go</mark> is great. <mark class="highlight">go</mark> <mark class="highlight">go</mark>!`,
+		},
+		{
+			name:     "text is escaped",
+			text:     "<b>Tom & Jerry</b>",
+			terms:    []string{"&", "b"},
+			tag:      "strong",
+			expected: `&lt;<strong class="highlight">b</strong>&gt;Tom <strong class="highlight">&amp;</strong> Jerry&lt;/<strong class="highlight">b</strong>&gt;`,
+		},
+		{
+			name:     "terms are not regular expressions",
+			text:     "a.b axb",
+			terms:    []string{"a.b"},
+			expected: `<mark class="highlight">a.b</mark> axb`,
+		},
+		{
+			name:     "longer terms are preferred",
+			text:     "template",
+			terms:    []string{"temp", "template"},
+			expected: `<mark class="highlight">template</mark>`,
+		},
+		{
+			name:     "invalid tags use mark",
+			text:     "abc",
+			terms:    []string{"b"},
+			tag:      `b onclick="alert(1)"`,
+			expected: `a<mark class="highlight">b</mark>c`,
+		},
+		{
+			name:     "no terms",
+			text:     "a < b",
+			terms:    []string{""},
+			expected: `a &lt; b`,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			b := new(bytes.Buffer)
+			if err := templ.NewHighlight(tt.text, tt.terms, tt.tag).Render(context.Background(), b); err != nil {
+				t.Fatalf("failed to render: %v", err)
+			}
+			if diff := cmp.Diff(tt.expected, b.String()); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
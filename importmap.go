@@ -0,0 +1,31 @@
+package templ
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+)
+
+// ImportMap maps ES module specifiers, e.g. "lit", to the URLs they're loaded from.
+type ImportMap map[string]SafeURL
+
+// RenderImportMap renders a <script type="importmap"> element containing the import map.
+// Each URL is sanitized using URL.
+//
+// The JSON is not HTML escaped, since script contents are not HTML, but <, > and & are
+// encoded as JSON unicode escapes, so that the JSON can't close the script element.
+func RenderImportMap(ctx context.Context, w io.Writer, im ImportMap) (err error) {
+	imports := make(map[string]SafeURL, len(im))
+	for specifier, url := range im {
+		imports[specifier] = URL(string(url))
+	}
+	b, err := json.Marshal(struct {
+		Imports map[string]SafeURL `json:"imports"`
+	}{
+		Imports: imports,
+	})
+	if err != nil {
+		return err
+	}
+	return writeStrings(w, `<script type="importmap">`, string(b), `</script>`)
+}
@@ -0,0 +1,58 @@
+package templ_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRenderImportMap(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    templ.ImportMap
+		expected string
+	}{
+		{
+			name:     "empty import maps are valid",
+			input:    nil,
+			expected: `<script type="importmap">{"imports":{}}</script>`,
+		},
+		{
+			name: "imports are sorted by specifier",
+			input: templ.ImportMap{
+				"vue": "https://cdn.example.com/vue.js",
+				"lit": "/modules/lit.js?v=1&min=true",
+			},
+			expected: `<script type="importmap">{"imports":{"lit":"/modules/lit.js?v=1\u0026min=true","vue":"https://cdn.example.com/vue.js"}}</script>`,
+		},
+		{
+			name: "unsafe URLs are sanitized",
+			input: templ.ImportMap{
+				"app": "javascript:alert(1)",
+			},
+			expected: `<script type="importmap">{"imports":{"app":"about:invalid#TemplFailedSanitizationURL"}}</script>`,
+		},
+		{
+			name: "the script element can't be closed",
+			input: templ.ImportMap{
+				"</script><script>alert(1)</script>": "/app.js",
+			},
+			expected: `<script type="importmap">{"imports":{"\u003c/script\u003e\u003cscript\u003ealert(1)\u003c/script\u003e":"/app.js"}}</script>`,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			b := new(bytes.Buffer)
+			if err := templ.RenderImportMap(context.Background(), b, tt.input); err != nil {
+				t.Fatalf("failed to render: %v", err)
+			}
+			if diff := cmp.Diff(tt.expected, b.String()); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package templ
+
+import (
+	"context"
+	"io"
+)
+
+// NewInfiniteScroll renders the initial content, followed by a sentinel element.
+// When the sentinel scrolls into view, the HTML at nextURL is fetched and
+// replaces the sentinel. The fetched HTML may include its own sentinel to
+// continue loading further pages.
+//
+// If htmx is loaded on the page, it's used to fetch and swap the content,
+// otherwise fetch is used.
+func NewInfiniteScroll(initialContent Component, nextURL SafeURL) Component {
+	return ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		if err = initialContent.Render(ctx, w); err != nil {
+			return err
+		}
+		if err = writeStrings(w, `<div data-infinite-scroll-target data-next="`, EscapeString(string(nextURL)), `"></div>`); err != nil {
+			return err
+		}
+		return infiniteScrollScript.Render(ctx, w)
+	})
+}
+
+var infiniteScrollScript = ComponentScript{
+	Name: `__templ_infiniteScroll`,
+	Function: `(function(){` +
+		`var observer=new IntersectionObserver(function(entries){entries.forEach(function(entry){` +
+		`if(!entry.isIntersecting){return;}` +
+		`var el=entry.target;observer.unobserve(el);` +
+		`var next=el.getAttribute("data-next");if(!next){return;}` +
+		`if(window.htmx){htmx.ajax("GET",next,{target:el,swap:"outerHTML"}).then(scan);return;}` +
+		`fetch(next).then(function(r){return r.text();}).then(function(html){el.insertAdjacentHTML("beforebegin",html);el.remove();scan();});` +
+		`});});` +
+		`function scan(){document.querySelectorAll("[data-infinite-scroll-target]:not([data-infinite-scroll-observed])").forEach(function(el){` +
+		`el.setAttribute("data-infinite-scroll-observed","");observer.observe(el);});}` +
+		// Sentinels rendered after the script are observed once the document has loaded.
+		`scan();if(document.readyState==="loading"){document.addEventListener("DOMContentLoaded",scan);}` +
+		`})();`,
+}
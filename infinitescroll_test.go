@@ -0,0 +1,47 @@
+package templ_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/a-h/templ"
+)
+
+func TestInfiniteScroll(t *testing.T) {
+	initial := templ.Raw("<ul><li>1</li></ul>")
+
+	t.Run("the initial content is followed by a sentinel", func(t *testing.T) {
+		b := new(bytes.Buffer)
+		err := templ.NewInfiniteScroll(initial, templ.URL("/items?page=2&size=10")).Render(context.Background(), b)
+		if err != nil {
+			t.Fatalf("failed to render: %v", err)
+		}
+		expected := `<ul><li>1</li></ul><div data-infinite-scroll-target data-next="/items?page=2&amp;size=10"></div><script type="text/javascript">`
+		if !strings.HasPrefix(b.String(), expected) {
+			t.Errorf("expected output to start with %q, got %q", expected, b.String())
+		}
+	})
+	t.Run("sentinels rendered after the script are observed when the document has loaded", func(t *testing.T) {
+		b := new(bytes.Buffer)
+		if err := templ.NewInfiniteScroll(initial, "/next").Render(context.Background(), b); err != nil {
+			t.Fatalf("failed to render: %v", err)
+		}
+		if !strings.Contains(b.String(), `document.addEventListener("DOMContentLoaded",scan)`) {
+			t.Errorf("expected the script to scan for sentinels on DOMContentLoaded, got %q", b.String())
+		}
+	})
+	t.Run("the script is only rendered once per context", func(t *testing.T) {
+		b := new(bytes.Buffer)
+		ctx := templ.InitializeContext(context.Background())
+		for i := 0; i < 2; i++ {
+			if err := templ.NewInfiniteScroll(initial, "/next").Render(ctx, b); err != nil {
+				t.Fatalf("failed to render: %v", err)
+			}
+		}
+		if count := strings.Count(b.String(), "<script"); count != 1 {
+			t.Errorf("expected 1 script element, got %d", count)
+		}
+	})
+}
@@ -0,0 +1,139 @@
+package templ
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"strconv"
+)
+
+var jsonViewerClass = newComponentCSSRules("jsonViewer",
+	`&{font-family:monospace;}`+
+		`& ul{list-style:none;margin:0;padding-left:1.5em;}`+
+		`& summary{cursor:pointer;}`+
+		`& .json-size{color:#6b7280;}`+
+		`& .json-key{color:#7c3aed;}`+
+		`& .json-string{color:#15803d;}`+
+		`& .json-number{color:#1d4ed8;}`+
+		`& .json-boolean{color:#b45309;}`+
+		`& .json-null{color:#6b7280;}`)
+
+// jsonNode is a JSON value, with object keys kept in order.
+type jsonNode struct {
+	// kind is object, array, string, number, boolean or null.
+	kind     string
+	value    string
+	keys     []string
+	children []jsonNode
+}
+
+// NewJSONViewer renders v, marshalled to JSON, as a tree. Objects and arrays are rendered
+// as details elements, which can be collapsed.
+func NewJSONViewer(v interface{}) Component {
+	return ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		data, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		d := json.NewDecoder(bytes.NewReader(data))
+		d.UseNumber()
+		root, err := decodeJSONNode(d)
+		if err != nil {
+			return err
+		}
+		if err = RenderCSSItems(ctx, w, jsonViewerClass); err != nil {
+			return err
+		}
+		if err = writeStrings(w, `<div class="json-viewer `, jsonViewerClass.ID, `">`); err != nil {
+			return err
+		}
+		if err = renderJSONNode(w, "", root); err != nil {
+			return err
+		}
+		_, err = io.WriteString(w, `</div>`)
+		return err
+	})
+}
+
+func decodeJSONNode(d *json.Decoder) (n jsonNode, err error) {
+	t, err := d.Token()
+	if err != nil {
+		return n, err
+	}
+	switch t := t.(type) {
+	case json.Delim:
+		if t == '{' {
+			n.kind = "object"
+		} else {
+			n.kind = "array"
+		}
+		for d.More() {
+			if n.kind == "object" {
+				key, err := d.Token()
+				if err != nil {
+					return n, err
+				}
+				n.keys = append(n.keys, key.(string))
+			}
+			child, err := decodeJSONNode(d)
+			if err != nil {
+				return n, err
+			}
+			n.children = append(n.children, child)
+		}
+		// Read the closing delimiter.
+		_, err = d.Token()
+		return n, err
+	case string:
+		return jsonNode{kind: "string", value: jsonQuote(t)}, nil
+	case json.Number:
+		return jsonNode{kind: "number", value: t.String()}, nil
+	case bool:
+		return jsonNode{kind: "boolean", value: strconv.FormatBool(t)}, nil
+	default:
+		return jsonNode{kind: "null", value: "null"}, nil
+	}
+}
+
+func renderJSONNode(w io.Writer, label string, n jsonNode) (err error) {
+	if n.kind != "object" && n.kind != "array" {
+		return writeStrings(w, label, `<span class="json-`, n.kind, `">`, EscapeString(n.value), `</span>`)
+	}
+	open, close := "[", "]"
+	if n.kind == "object" {
+		open, close = "{", "}"
+	}
+	if err = writeStrings(w,
+		`<details open><summary>`, label,
+		`<span class="json-size">`, open, strconv.Itoa(len(n.children)), close, `</span></summary><ul>`); err != nil {
+		return err
+	}
+	for i, child := range n.children {
+		var childLabel string
+		if n.kind == "object" {
+			childLabel = `<span class="json-key">` + EscapeString(jsonQuote(n.keys[i])) + `</span>: `
+		}
+		if _, err = io.WriteString(w, `<li>`); err != nil {
+			return err
+		}
+		if err = renderJSONNode(w, childLabel, child); err != nil {
+			return err
+		}
+		if _, err = io.WriteString(w, `</li>`); err != nil {
+			return err
+		}
+	}
+	_, err = io.WriteString(w, `</ul></details>`)
+	return err
+}
+
+// jsonQuote returns the string as a JSON string literal. HTML characters are not escaped,
+// because the output is HTML escaped when rendered.
+func jsonQuote(s string) string {
+	var b bytes.Buffer
+	enc := json.NewEncoder(&b)
+	enc.SetEscapeHTML(false)
+	_ = enc.Encode(s)
+	return string(bytes.TrimSuffix(b.Bytes(), []byte("\n")))
+}
@@ -0,0 +1,70 @@
+package templ_test
+
+import (
+	"bytes"
+	"context"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/google/go-cmp/cmp"
+)
+
+var jsonViewerClassPattern = regexp.MustCompile(`jsonViewer_[0-9a-f]{4}`)
+
+func TestJSONViewer(t *testing.T) {
+	type person struct {
+		Name    string   `json:"name"`
+		Age     int      `json:"age"`
+		Admin   bool     `json:"admin"`
+		Manager *person  `json:"manager"`
+		Tags    []string `json:"tags"`
+	}
+	tests := []struct {
+		name     string
+		input    any
+		expected string
+	}{
+		{
+			name:     "scalar values",
+			input:    1.5,
+			expected: `<div class="json-viewer jsonViewer"><span class="json-number">1.5</span></div>`,
+		},
+		{
+			name:  "object keys are kept in order",
+			input: person{Name: "<Alice>", Age: 42, Tags: []string{"a"}},
+			expected: `<div class="json-viewer jsonViewer"><details open><summary><span class="json-size">{5}</span></summary><ul>` +
+				`<li><span class="json-key">&#34;name&#34;</span>: <span class="json-string">&#34;&lt;Alice&gt;&#34;</span></li>` +
+				`<li><span class="json-key">&#34;age&#34;</span>: <span class="json-number">42</span></li>` +
+				`<li><span class="json-key">&#34;admin&#34;</span>: <span class="json-boolean">false</span></li>` +
+				`<li><span class="json-key">&#34;manager&#34;</span>: <span class="json-null">null</span></li>` +
+				`<li><details open><summary><span class="json-key">&#34;tags&#34;</span>: <span class="json-size">[1]</span></summary><ul>` +
+				`<li><span class="json-string">&#34;a&#34;</span></li>` +
+				`</ul></details></li>` +
+				`</ul></details></div>`,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			b := new(bytes.Buffer)
+			if err := templ.NewJSONViewer(tt.input).Render(context.Background(), b); err != nil {
+				t.Fatalf("failed to render: %v", err)
+			}
+			output := jsonViewerClassPattern.ReplaceAllString(b.String(), "jsonViewer")
+			style, html, ok := strings.Cut(output, "</style>")
+			if !ok || !strings.Contains(style, ".jsonViewer .json-string") {
+				t.Errorf("expected the CSS to be rendered, got %q", style)
+			}
+			if diff := cmp.Diff(tt.expected, html); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+	t.Run("values that can't be marshalled return an error", func(t *testing.T) {
+		if err := templ.NewJSONViewer(make(chan int)).Render(context.Background(), new(bytes.Buffer)); err == nil {
+			t.Error("expected an error")
+		}
+	})
+}
@@ -0,0 +1,95 @@
+package templ
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// CSSKeyframes is a CSS @keyframes animation.
+type CSSKeyframes struct {
+	// Name of the animation, used in the animation-name property.
+	Name string
+	// Frames maps keyframe selectors, e.g. "from", "to", "50%", or "0%, 100%", to the CSS
+	// declarations of the keyframe.
+	Frames map[string]SafeCSS
+}
+
+var keyframeSelector = regexp.MustCompile(`^(?:from|to|[0-9]+(?:\.[0-9]+)?%)(?:\s*,\s*(?:from|to|[0-9]+(?:\.[0-9]+)?%))*$`)
+
+// RenderKeyframes renders a <style> element containing the @keyframes rules that haven't
+// already been rendered in the context. Keyframes are tracked by name.
+//
+// An error is returned if a name isn't a valid CSS identifier, or a keyframe selector
+// isn't from, to, or a percentage.
+func RenderKeyframes(ctx context.Context, w io.Writer, kf ...CSSKeyframes) (err error) {
+	_, v := getContext(ctx)
+	sb := new(strings.Builder)
+	var rendered []string
+	for _, k := range kf {
+		if v.hasKeyframesBeenRendered(k.Name) || slices.Contains(rendered, k.Name) {
+			continue
+		}
+		if err = writeKeyframes(sb, k); err != nil {
+			return err
+		}
+		rendered = append(rendered, k.Name)
+	}
+	if len(rendered) == 0 {
+		return nil
+	}
+	for _, name := range rendered {
+		v.addKeyframes(name)
+	}
+	return writeStrings(w, `<style type="text/css">`, sb.String(), `</style>`)
+}
+
+func writeKeyframes(sb *strings.Builder, k CSSKeyframes) error {
+	if !ValidCSSClassName(k.Name) {
+		return fmt.Errorf("templ: invalid keyframes name %q", k.Name)
+	}
+	selectors := make([]string, 0, len(k.Frames))
+	for selector := range k.Frames {
+		if !keyframeSelector.MatchString(selector) {
+			return fmt.Errorf("templ: invalid keyframe selector %q in keyframes %q", selector, k.Name)
+		}
+		selectors = append(selectors, selector)
+	}
+	sort.Slice(selectors, func(i, j int) bool {
+		pi, pj := keyframePosition(selectors[i]), keyframePosition(selectors[j])
+		if pi != pj {
+			return pi < pj
+		}
+		return selectors[i] < selectors[j]
+	})
+	sb.WriteString("@keyframes ")
+	sb.WriteString(k.Name)
+	sb.WriteString("{")
+	for _, selector := range selectors {
+		sb.WriteString(selector)
+		sb.WriteString("{")
+		sb.WriteString(string(k.Frames[selector]))
+		sb.WriteString("}")
+	}
+	sb.WriteString("}")
+	return nil
+}
+
+// keyframePosition returns the percentage of the first keyframe in the selector, so that
+// keyframes can be rendered in order.
+func keyframePosition(selector string) float64 {
+	first, _, _ := strings.Cut(selector, ",")
+	switch first = strings.TrimSpace(first); first {
+	case "from":
+		return 0
+	case "to":
+		return 100
+	}
+	f, _ := strconv.ParseFloat(strings.TrimSuffix(first, "%"), 64)
+	return f
+}
@@ -0,0 +1,65 @@
+package templ_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRenderKeyframes(t *testing.T) {
+	fade := templ.CSSKeyframes{
+		Name: "fade",
+		Frames: map[string]templ.SafeCSS{
+			"to":   "opacity:1;",
+			"from": "opacity:0;",
+			"50%":  "opacity:0.8;",
+		},
+	}
+	spin := templ.CSSKeyframes{
+		Name: "spin",
+		Frames: map[string]templ.SafeCSS{
+			"to": "transform:rotate(360deg);",
+		},
+	}
+	t.Run("keyframes are rendered in order, once per context", func(t *testing.T) {
+		ctx := templ.InitializeContext(context.Background())
+		b := new(bytes.Buffer)
+		if err := templ.RenderKeyframes(ctx, b, fade); err != nil {
+			t.Fatalf("failed to render: %v", err)
+		}
+		expected := `<style type="text/css">@keyframes fade{from{opacity:0;}50%{opacity:0.8;}to{opacity:1;}}</style>`
+		if diff := cmp.Diff(expected, b.String()); diff != "" {
+			t.Error(diff)
+		}
+		b.Reset()
+		if err := templ.RenderKeyframes(ctx, b, fade, spin); err != nil {
+			t.Fatalf("failed to render: %v", err)
+		}
+		expected = `<style type="text/css">@keyframes spin{to{transform:rotate(360deg);}}</style>`
+		if diff := cmp.Diff(expected, b.String()); diff != "" {
+			t.Error(diff)
+		}
+		b.Reset()
+		if err := templ.RenderKeyframes(ctx, b, fade, spin); err != nil {
+			t.Fatalf("failed to render: %v", err)
+		}
+		if b.Len() != 0 {
+			t.Errorf("expected nothing to be rendered, got %q", b.String())
+		}
+	})
+	t.Run("invalid names are rejected", func(t *testing.T) {
+		err := templ.RenderKeyframes(context.Background(), new(bytes.Buffer), templ.CSSKeyframes{Name: "a{}", Frames: spin.Frames})
+		if err == nil {
+			t.Error("expected an error")
+		}
+	})
+	t.Run("invalid selectors are rejected", func(t *testing.T) {
+		err := templ.RenderKeyframes(context.Background(), new(bytes.Buffer), templ.CSSKeyframes{Name: "a", Frames: map[string]templ.SafeCSS{"}body": ""}})
+		if err == nil {
+			t.Error("expected an error")
+		}
+	})
+}
@@ -0,0 +1,46 @@
+package templ
+
+import (
+	"context"
+	"io"
+)
+
+// LocaleOption is a locale that users can switch to.
+type LocaleOption struct {
+	// Code is the language tag of the locale, e.g. "en-GB".
+	Code  string
+	Label string
+	// URL of the page in the locale.
+	URL SafeURL
+}
+
+// NewLanguageSwitcher renders a list of links to the page in each locale. The link to the
+// current locale is marked with aria-current. If current is empty, the locale is read from
+// the context using LocaleFromContext.
+func NewLanguageSwitcher(current string, locales []LocaleOption) Component {
+	return ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		locale := current
+		if locale == "" {
+			locale = LocaleFromContext(ctx)
+		}
+		if _, err = io.WriteString(w, `<nav class="language-switcher" aria-label="Language"><ul>`); err != nil {
+			return err
+		}
+		for _, l := range locales {
+			code := EscapeString(l.Code)
+			if err = writeStrings(w, `<li><a href="`, EscapeString(string(l.URL)), `" hreflang="`, code, `" lang="`, code, `"`); err != nil {
+				return err
+			}
+			if l.Code == locale {
+				if _, err = io.WriteString(w, ` aria-current="true"`); err != nil {
+					return err
+				}
+			}
+			if err = writeStrings(w, `>`, EscapeString(l.Label), `</a></li>`); err != nil {
+				return err
+			}
+		}
+		_, err = io.WriteString(w, `</ul></nav>`)
+		return err
+	})
+}
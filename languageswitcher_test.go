@@ -0,0 +1,53 @@
+package templ_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestLanguageSwitcher(t *testing.T) {
+	locales := []templ.LocaleOption{
+		{Code: "en", Label: "English", URL: "/en/"},
+		{Code: "fr", Label: "Français", URL: "/fr/"},
+	}
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		current  string
+		expected string
+	}{
+		{
+			name:    "the current locale is marked",
+			ctx:     context.Background(),
+			current: "fr",
+			expected: `<nav class="language-switcher" aria-label="Language"><ul>` +
+				`<li><a href="/en/" hreflang="en" lang="en">English</a></li>` +
+				`<li><a href="/fr/" hreflang="fr" lang="fr" aria-current="true">Français</a></li>` +
+				`</ul></nav>`,
+		},
+		{
+			name: "the current locale is read from the context if not set",
+			ctx:  templ.WithLocale(context.Background(), "en"),
+			expected: `<nav class="language-switcher" aria-label="Language"><ul>` +
+				`<li><a href="/en/" hreflang="en" lang="en" aria-current="true">English</a></li>` +
+				`<li><a href="/fr/" hreflang="fr" lang="fr">Français</a></li>` +
+				`</ul></nav>`,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			b := new(bytes.Buffer)
+			if err := templ.NewLanguageSwitcher(tt.current, locales).Render(tt.ctx, b); err != nil {
+				t.Fatalf("failed to render: %v", err)
+			}
+			if diff := cmp.Diff(tt.expected, b.String()); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
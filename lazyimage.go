@@ -0,0 +1,36 @@
+package templ
+
+import (
+	"context"
+	"io"
+)
+
+// NewLazyImage renders an img element that displays the placeholder until the image
+// scrolls into view, at which point src is loaded.
+//
+// Unlike loading="lazy", this uses IntersectionObserver, so it's supported by older browsers.
+func NewLazyImage(src SafeURL, alt string, placeholder SafeURL) Component {
+	return ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		if err = writeStrings(w,
+			`<img data-src="`, EscapeString(string(src)),
+			`" src="`, EscapeString(string(placeholder)),
+			`" alt="`, EscapeString(alt),
+			`" class="lazy">`); err != nil {
+			return err
+		}
+		return lazyImageScript.Render(ctx, w)
+	})
+}
+
+var lazyImageScript = ComponentScript{
+	Name: `__templ_lazyImage`,
+	Function: `(function(){` +
+		`function load(img){img.src=img.getAttribute("data-src");img.removeAttribute("data-src");img.classList.remove("lazy");}` +
+		`if(!("IntersectionObserver" in window)){document.querySelectorAll("img.lazy[data-src]").forEach(load);return;}` +
+		`var observer=new IntersectionObserver(function(entries){entries.forEach(function(entry){` +
+		`if(!entry.isIntersecting){return;}observer.unobserve(entry.target);load(entry.target);` +
+		`});});` +
+		`function scan(){document.querySelectorAll("img.lazy[data-src]").forEach(function(img){observer.observe(img);});}` +
+		`if(document.readyState==="loading"){document.addEventListener("DOMContentLoaded",scan);}else{scan();}` +
+		`})();`,
+}
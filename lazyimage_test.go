@@ -0,0 +1,39 @@
+package templ_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/a-h/templ"
+)
+
+func TestLazyImage(t *testing.T) {
+	t.Run("the placeholder is loaded, and the image source is deferred", func(t *testing.T) {
+		b := new(bytes.Buffer)
+		err := templ.NewLazyImage(templ.URL("/cat.jpg?w=1&h=2"), `A "cat"`, templ.URL("/placeholder.svg")).Render(context.Background(), b)
+		if err != nil {
+			t.Fatalf("failed to render: %v", err)
+		}
+		expected := `<img data-src="/cat.jpg?w=1&amp;h=2" src="/placeholder.svg" alt="A &#34;cat&#34;" class="lazy"><script type="text/javascript">`
+		if !strings.HasPrefix(b.String(), expected) {
+			t.Errorf("expected output to start with %q, got %q", expected, b.String())
+		}
+	})
+	t.Run("the script is only rendered once per context", func(t *testing.T) {
+		b := new(bytes.Buffer)
+		ctx := templ.InitializeContext(context.Background())
+		for i := 0; i < 3; i++ {
+			if err := templ.NewLazyImage("/a.jpg", "a", "/p.svg").Render(ctx, b); err != nil {
+				t.Fatalf("failed to render: %v", err)
+			}
+		}
+		if count := strings.Count(b.String(), "<script"); count != 1 {
+			t.Errorf("expected 1 script element, got %d", count)
+		}
+		if count := strings.Count(b.String(), "<img"); count != 3 {
+			t.Errorf("expected 3 img elements, got %d", count)
+		}
+	})
+}
@@ -0,0 +1,82 @@
+package templ
+
+import (
+	"context"
+	"io"
+	"strconv"
+)
+
+// LightboxImage is an image shown in a lightbox.
+type LightboxImage struct {
+	Src     SafeURL
+	Alt     string
+	Caption string
+}
+
+var lightboxClass = newComponentCSSRules("lightbox",
+	`& ul{list-style:none;padding:0;display:flex;flex-wrap:wrap;gap:0.5em;}`+
+		`& ul button{padding:0;border:none;background:none;cursor:zoom-in;}`+
+		`& ul img{display:block;width:8em;height:8em;object-fit:cover;}`+
+		`& [role=dialog]{position:fixed;inset:0;z-index:1000;display:flex;align-items:center;justify-content:center;gap:1em;background:rgba(0,0,0,0.9);color:#fff;}`+
+		`& [role=dialog][hidden]{display:none;}`+
+		`& figure{margin:0;text-align:center;}`+
+		`& figure img{max-width:80vw;max-height:80vh;}`+
+		`& [role=dialog] button{background:none;border:none;color:inherit;font-size:2em;cursor:pointer;}`+
+		`& [data-lightbox-close]{position:absolute;top:0.5em;right:0.5em;}`)
+
+// NewLightbox renders a gallery of image thumbnails. Selecting a thumbnail opens the image
+// in a full-screen overlay, with buttons to move to the previous or next image. The
+// overlay can also be controlled with the arrow keys, and closed with Escape.
+func NewLightbox(images []LightboxImage) Component {
+	return ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		if err = RenderCSSItems(ctx, w, lightboxClass); err != nil {
+			return err
+		}
+		if err = writeStrings(w, `<div class="lightbox `, lightboxClass.ID, `" data-lightbox><ul>`); err != nil {
+			return err
+		}
+		n := strconv.Itoa(len(images))
+		for i, image := range images {
+			src, alt := EscapeString(string(image.Src)), EscapeString(image.Alt)
+			if err = writeStrings(w,
+				`<li><button type="button" aria-label="View image `, strconv.Itoa(i+1), ` of `, n, `" data-lightbox-src="`, src,
+				`" data-lightbox-caption="`, EscapeString(image.Caption), `">`,
+				`<img src="`, src, `" alt="`, alt, `" loading="lazy"></button></li>`); err != nil {
+				return err
+			}
+		}
+		if err = writeStrings(w,
+			`</ul><div role="dialog" aria-modal="true" aria-label="Image viewer" hidden>`,
+			`<button type="button" aria-label="Close" data-lightbox-close>&times;</button>`,
+			`<button type="button" aria-label="Previous image" data-lightbox-prev>&lsaquo;</button>`,
+			`<figure><img alt="" data-lightbox-image><figcaption aria-live="polite"></figcaption></figure>`,
+			`<button type="button" aria-label="Next image" data-lightbox-next>&rsaquo;</button>`,
+			`</div></div>`); err != nil {
+			return err
+		}
+		return lightboxScript.Render(ctx, w)
+	})
+}
+
+var lightboxScript = ComponentScript{
+	Name: `__templ_lightbox`,
+	Function: `(function(){` +
+		`var open=null;` +
+		`function thumbs(lb){return lb.querySelectorAll("ul button");}` +
+		`function show(lb,i){var t=thumbs(lb);i=(i+t.length)%t.length;var dialog=lb.querySelector("[role=dialog]");` +
+		`var img=dialog.querySelector("[data-lightbox-image]");var thumb=t[i];` +
+		`img.src=thumb.getAttribute("data-lightbox-src");img.alt=thumb.querySelector("img").alt;` +
+		`dialog.querySelector("figcaption").textContent=thumb.getAttribute("data-lightbox-caption");` +
+		`dialog.setAttribute("aria-label","Image "+(i+1)+" of "+t.length);` +
+		`if(dialog.hidden){dialog.hidden=false;dialog.querySelector("[data-lightbox-close]").focus();}` +
+		`open={lb:lb,index:i};}` +
+		`function close(){if(!open){return;}open.lb.querySelector("[role=dialog]").hidden=true;thumbs(open.lb)[open.index].focus();open=null;}` +
+		`document.addEventListener("click",function(e){var lb=e.target.closest&&e.target.closest("[data-lightbox]");if(!lb){return;}` +
+		`var thumb=e.target.closest("ul button");if(thumb){show(lb,Array.prototype.indexOf.call(thumbs(lb),thumb));return;}` +
+		`if(e.target.closest("[data-lightbox-close]")||e.target.getAttribute("role")==="dialog"){close();}` +
+		`else if(e.target.closest("[data-lightbox-prev]")){show(lb,open.index-1);}` +
+		`else if(e.target.closest("[data-lightbox-next]")){show(lb,open.index+1);}});` +
+		`document.addEventListener("keydown",function(e){if(!open){return;}` +
+		`if(e.key==="Escape"){close();}else if(e.key==="ArrowLeft"){show(open.lb,open.index-1);}else if(e.key==="ArrowRight"){show(open.lb,open.index+1);}});` +
+		`})();`,
+}
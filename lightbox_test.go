@@ -0,0 +1,46 @@
+package templ_test
+
+import (
+	"bytes"
+	"context"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/google/go-cmp/cmp"
+)
+
+var lightboxClassPattern = regexp.MustCompile(`lightbox_[0-9a-f]{4}`)
+
+func TestLightbox(t *testing.T) {
+	images := []templ.LightboxImage{
+		{Src: "/a.jpg", Alt: "A cat", Caption: "Cats & dogs"},
+		{Src: "/b.jpg", Alt: "A dog"},
+	}
+	b := new(bytes.Buffer)
+	if err := templ.NewLightbox(images).Render(context.Background(), b); err != nil {
+		t.Fatalf("failed to render: %v", err)
+	}
+	output := lightboxClassPattern.ReplaceAllString(b.String(), "lightbox")
+	style, rest, ok := strings.Cut(output, "</style>")
+	if !ok || !strings.Contains(style, ".lightbox figure img{") {
+		t.Errorf("expected the CSS to be rendered, got %q", style)
+	}
+	html, script, _ := strings.Cut(rest, "<script")
+	expected := `<div class="lightbox lightbox" data-lightbox><ul>` +
+		`<li><button type="button" aria-label="View image 1 of 2" data-lightbox-src="/a.jpg" data-lightbox-caption="Cats &amp; dogs"><img src="/a.jpg" alt="A cat" loading="lazy"></button></li>` +
+		`<li><button type="button" aria-label="View image 2 of 2" data-lightbox-src="/b.jpg" data-lightbox-caption=""><img src="/b.jpg" alt="A dog" loading="lazy"></button></li>` +
+		`</ul><div role="dialog" aria-modal="true" aria-label="Image viewer" hidden>` +
+		`<button type="button" aria-label="Close" data-lightbox-close>&times;</button>` +
+		`<button type="button" aria-label="Previous image" data-lightbox-prev>&lsaquo;</button>` +
+		`<figure><img alt="" data-lightbox-image><figcaption aria-live="polite"></figcaption></figure>` +
+		`<button type="button" aria-label="Next image" data-lightbox-next>&rsaquo;</button>` +
+		`</div></div>`
+	if diff := cmp.Diff(expected, html); diff != "" {
+		t.Error(diff)
+	}
+	if !strings.Contains(script, "ArrowRight") {
+		t.Errorf("expected the script to be rendered, got %q", script)
+	}
+}
@@ -0,0 +1,35 @@
+package templ
+
+import (
+	"context"
+	"io"
+	"strconv"
+)
+
+// ARIALive is the politeness of an ARIA live region.
+type ARIALive string
+
+const (
+	// ARIALivePolite regions are announced when the user is idle.
+	ARIALivePolite ARIALive = "polite"
+	// ARIALiveAssertive regions are announced immediately, interrupting the user.
+	ARIALiveAssertive ARIALive = "assertive"
+)
+
+// NewLiveRegion renders an empty, visually hidden ARIA live region. Changes to the content
+// of the region are announced by screen readers. If atomic is true, the whole region is
+// announced when any part of it changes.
+func NewLiveRegion(id string, politeness ARIALive, atomic bool) Component {
+	return ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		if err = RenderCSSItems(ctx, w, srOnlyClass); err != nil {
+			return err
+		}
+		live := ARIALivePolite
+		if politeness == ARIALiveAssertive {
+			live = ARIALiveAssertive
+		}
+		return writeStrings(w,
+			`<div id="`, EscapeString(id), `" aria-live="`, string(live), `" aria-atomic="`, strconv.FormatBool(atomic),
+			`" class="sr-only `, srOnlyClass.ID, `"></div>`)
+	})
+}
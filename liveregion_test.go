@@ -0,0 +1,54 @@
+package templ_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestLiveRegion(t *testing.T) {
+	tests := []struct {
+		name       string
+		politeness templ.ARIALive
+		atomic     bool
+		expected   string
+	}{
+		{
+			name:       "polite",
+			politeness: templ.ARIALivePolite,
+			expected:   `<div id="updates" aria-live="polite" aria-atomic="false" class="sr-only srOnly"></div>`,
+		},
+		{
+			name:       "assertive and atomic",
+			politeness: templ.ARIALiveAssertive,
+			atomic:     true,
+			expected:   `<div id="updates" aria-live="assertive" aria-atomic="true" class="sr-only srOnly"></div>`,
+		},
+		{
+			name:       "unknown politeness values are polite",
+			politeness: `off" onclick="alert(1)`,
+			expected:   `<div id="updates" aria-live="polite" aria-atomic="false" class="sr-only srOnly"></div>`,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			b := new(bytes.Buffer)
+			if err := templ.NewLiveRegion("updates", tt.politeness, tt.atomic).Render(context.Background(), b); err != nil {
+				t.Fatalf("failed to render: %v", err)
+			}
+			output := srOnlyClassPattern.ReplaceAllString(b.String(), "srOnly")
+			_, html, ok := strings.Cut(output, "</style>")
+			if !ok {
+				t.Errorf("expected the CSS to be rendered, got %q", output)
+			}
+			if diff := cmp.Diff(tt.expected, html); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,20 @@
+package templ
+
+import "context"
+
+type localeContextKeyType int
+
+const localeContextKey = localeContextKeyType(0)
+
+// WithLocale stores the locale of the request in the context, e.g. "en-GB", so that
+// components can render localised content.
+func WithLocale(ctx context.Context, locale string) context.Context {
+	return context.WithValue(ctx, localeContextKey, locale)
+}
+
+// LocaleFromContext returns the locale stored in the context by WithLocale, or an empty
+// string.
+func LocaleFromContext(ctx context.Context) string {
+	locale, _ := ctx.Value(localeContextKey).(string)
+	return locale
+}
@@ -0,0 +1,22 @@
+package templ_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/a-h/templ"
+)
+
+func TestLocale(t *testing.T) {
+	t.Run("the locale is empty by default", func(t *testing.T) {
+		if locale := templ.LocaleFromContext(context.Background()); locale != "" {
+			t.Errorf("expected no locale, got %q", locale)
+		}
+	})
+	t.Run("the locale can be read from the context", func(t *testing.T) {
+		ctx := templ.WithLocale(context.Background(), "en-GB")
+		if locale := templ.LocaleFromContext(ctx); locale != "en-GB" {
+			t.Errorf("expected en-GB, got %q", locale)
+		}
+	})
+}
@@ -0,0 +1,102 @@
+package templ
+
+import (
+	"context"
+	"io"
+	"math"
+	"strconv"
+	"time"
+)
+
+// MaintenancePageOption configures a maintenance page.
+type MaintenancePageOption func(*maintenancePageConfig)
+
+type maintenancePageConfig struct {
+	canonicalURL SafeURL
+}
+
+// WithCanonicalURL sets the canonical URL of the maintenance page, so that search engines
+// don't index the maintenance page in place of the pages it replaces.
+func WithCanonicalURL(url SafeURL) MaintenancePageOption {
+	return func(c *maintenancePageConfig) {
+		c.canonicalURL = url
+	}
+}
+
+// maintenancePageRetryInterval is how often the page is reloaded once the eta has passed.
+const maintenancePageRetryInterval = time.Minute
+
+// NewMaintenancePage renders a complete HTML document explaining that the site is in
+// maintenance mode, with a countdown to the eta. The page reloads when the eta is reached,
+// and every minute after that, until the site is available.
+func NewMaintenancePage(message string, eta time.Time, opts ...MaintenancePageOption) Component {
+	var config maintenancePageConfig
+	for _, o := range opts {
+		o(&config)
+	}
+	return ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		remaining := time.Until(eta)
+		refresh := maintenancePageRetryInterval
+		if remaining > 0 {
+			refresh = remaining
+		}
+		if err = writeStrings(w,
+			`<!DOCTYPE html><html lang="en"><head>`,
+			`<meta charset="utf-8">`,
+			`<meta name="viewport" content="width=device-width, initial-scale=1">`,
+			`<meta name="robots" content="noindex">`,
+			`<meta http-equiv="refresh" content="`, strconv.Itoa(int(math.Ceil(refresh.Seconds()))), `">`,
+			`<title>Down for maintenance</title>`); err != nil {
+			return err
+		}
+		if config.canonicalURL != "" {
+			if err = writeStrings(w, `<link rel="canonical" href="`, EscapeString(string(config.canonicalURL)), `">`); err != nil {
+				return err
+			}
+		}
+		if err = writeStrings(w,
+			`</head><body><main class="maintenance-page">`,
+			`<h1>Down for maintenance</h1>`,
+			`<p>`, EscapeString(message), `</p>`,
+			`<p>Expected back <time datetime="`, eta.UTC().Format(time.RFC3339), `" data-maintenance-eta>`, formatMaintenanceCountdown(remaining), `</time></p>`,
+			`</main>`); err != nil {
+			return err
+		}
+		if err = maintenancePageScript.Render(ctx, w); err != nil {
+			return err
+		}
+		_, err = io.WriteString(w, `</body></html>`)
+		return err
+	})
+}
+
+// formatMaintenanceCountdown formats the remaining time, e.g. "in 1h 2m 3s", in the same
+// way as the countdown script.
+func formatMaintenanceCountdown(d time.Duration) string {
+	if d <= 0 {
+		return "shortly"
+	}
+	s := int(math.Ceil(d.Seconds()))
+	h, m := s/3600, s%3600/60
+	s = s % 60
+	out := "in "
+	if h > 0 {
+		out += strconv.Itoa(h) + "h "
+	}
+	if h > 0 || m > 0 {
+		out += strconv.Itoa(m) + "m "
+	}
+	return out + strconv.Itoa(s) + "s"
+}
+
+var maintenancePageScript = ComponentScript{
+	Name: `__templ_maintenancePage`,
+	Function: `(function(){` +
+		`var el=document.querySelector("[data-maintenance-eta]");if(!el){return;}var eta=new Date(el.getAttribute("datetime")).getTime();` +
+		`function tick(){var s=Math.ceil((eta-Date.now())/1000);` +
+		`if(s<=0){el.textContent="shortly";window.location.reload();return;}` +
+		`var h=Math.floor(s/3600),m=Math.floor(s%3600/60);var out="in ";if(h>0){out+=h+"h ";}if(h>0||m>0){out+=m+"m ";}` +
+		`el.textContent=out+(s%60)+"s";setTimeout(tick,1000);}` +
+		`tick();` +
+		`})();`,
+}
@@ -0,0 +1,55 @@
+package templ_test
+
+import (
+	"bytes"
+	"context"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/a-h/templ"
+)
+
+func TestMaintenancePage(t *testing.T) {
+	t.Run("the page refreshes when the eta is reached", func(t *testing.T) {
+		b := new(bytes.Buffer)
+		eta := time.Now().Add(90 * time.Minute)
+		if err := templ.NewMaintenancePage("Upgrading <database>.", eta, templ.WithCanonicalURL("https://example.com/")).Render(context.Background(), b); err != nil {
+			t.Fatalf("failed to render: %v", err)
+		}
+		output := b.String()
+		if !strings.HasPrefix(output, `<!DOCTYPE html><html lang="en"><head>`) || !strings.HasSuffix(output, `</body></html>`) {
+			t.Errorf("expected a complete HTML document, got %q", output)
+		}
+		for _, expected := range []string{
+			`<meta http-equiv="refresh" content="5400">`,
+			`<link rel="canonical" href="https://example.com/">`,
+			`<p>Upgrading &lt;database&gt;.</p>`,
+			`<p>Expected back <time datetime="` + eta.UTC().Format(time.RFC3339) + `" data-maintenance-eta>in 1h 30m 0s</time></p>`,
+			`<script`,
+		} {
+			if !strings.Contains(output, expected) {
+				t.Errorf("expected output to contain %q, got %q", expected, output)
+			}
+		}
+	})
+	t.Run("the page refreshes every minute after the eta has passed", func(t *testing.T) {
+		b := new(bytes.Buffer)
+		if err := templ.NewMaintenancePage("Upgrading.", time.Now().Add(-time.Hour)).Render(context.Background(), b); err != nil {
+			t.Fatalf("failed to render: %v", err)
+		}
+		output := b.String()
+		for _, expected := range []string{
+			`<meta http-equiv="refresh" content="60">`,
+			`data-maintenance-eta>shortly</time>`,
+		} {
+			if !strings.Contains(output, expected) {
+				t.Errorf("expected output to contain %q, got %q", expected, output)
+			}
+		}
+		if regexp.MustCompile(`rel="canonical"`).MatchString(output) {
+			t.Error("expected no canonical URL")
+		}
+	})
+}
@@ -0,0 +1,58 @@
+package templ
+
+import (
+	"context"
+	"io"
+)
+
+// NewMentionInput renders a labelled textarea, where typing @ followed by a prefix shows
+// a listbox of suggestions. Selecting a suggestion replaces the prefix with the suggestion.
+//
+// Suggestions are fetched from the suggestURL, with the prefix passed in the q query string
+// parameter. The endpoint must return a JSON array of strings.
+func NewMentionInput(name, label string, value string, suggestURL SafeURL) Component {
+	return ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		id := EscapeString(name)
+		listboxID := id + "-mentions"
+		if err = writeStrings(w,
+			`<div class="mention-input">`,
+			`<label for="`, id, `">`, EscapeString(label), `</label>`,
+			`<textarea id="`, id, `" name="`, id, `" aria-autocomplete="list" aria-expanded="false" aria-controls="`, listboxID,
+			`" data-mention-url="`, EscapeString(string(suggestURL)), `">`, EscapeString(value), `</textarea>`,
+			`<ul id="`, listboxID, `" role="listbox" aria-label="Suggestions" hidden></ul>`,
+			`</div>`); err != nil {
+			return err
+		}
+		return mentionInputScript.Render(ctx, w)
+	})
+}
+
+var mentionInputScript = ComponentScript{
+	Name: `__templ_mentionInput`,
+	Function: `(function(){` +
+		`var state=new WeakMap();` +
+		`function listbox(input){return document.getElementById(input.getAttribute("aria-controls"));}` +
+		`function mention(input){var m=/(^|\s)@(\w*)$/.exec(input.value.slice(0,input.selectionStart));return m?m[2]:null;}` +
+		`function show(input,visible){listbox(input).hidden=!visible;input.setAttribute("aria-expanded",String(visible));if(!visible){highlight(input,-1);}}` +
+		`function highlight(input,i){var opts=listbox(input).querySelectorAll("[role=option]");` +
+		`opts.forEach(function(o,j){o.setAttribute("aria-selected",String(i===j));});state.set(input,i);` +
+		`if(i>=0&&opts[i]){input.setAttribute("aria-activedescendant",opts[i].id);}else{input.removeAttribute("aria-activedescendant");}}` +
+		`function select(input,o){var end=input.selectionStart;var start=input.value.lastIndexOf("@",end-1);var text="@"+o.textContent+" ";` +
+		`input.value=input.value.slice(0,start)+text+input.value.slice(end);input.selectionStart=input.selectionEnd=start+text.length;show(input,false);input.focus();}` +
+		`function render(input,items){var lb=listbox(input);lb.innerHTML="";items.forEach(function(s,i){var li=document.createElement("li");` +
+		`li.setAttribute("role","option");li.id=lb.id+"-"+i;li.setAttribute("aria-selected","false");li.textContent=s;lb.appendChild(li);});show(input,items.length>0);}` +
+		`document.addEventListener("input",function(e){var input=e.target;if(!input.hasAttribute||!input.hasAttribute("data-mention-url")){return;}` +
+		`var q=mention(input);if(q===null){show(input,false);return;}` +
+		`var u=new URL(input.getAttribute("data-mention-url"),window.location.href);u.searchParams.set("q",q);` +
+		`fetch(u).then(function(r){return r.json();}).then(function(items){if(mention(input)===q){render(input,items);}});});` +
+		`document.addEventListener("keydown",function(e){var input=e.target;if(!input.hasAttribute||!input.hasAttribute("data-mention-url")){return;}` +
+		`var lb=listbox(input);var opts=lb.querySelectorAll("[role=option]");if(lb.hidden||!opts.length){return;}var active=state.has(input)?state.get(input):-1;` +
+		`if(e.key==="ArrowDown"){e.preventDefault();highlight(input,(active+1)%opts.length);}` +
+		`else if(e.key==="ArrowUp"){e.preventDefault();highlight(input,(active-1+opts.length)%opts.length);}` +
+		`else if((e.key==="Enter"||e.key==="Tab")&&active>=0){e.preventDefault();select(input,opts[active]);}` +
+		`else if(e.key==="Escape"){show(input,false);}});` +
+		`document.addEventListener("mousedown",function(e){var o=e.target.closest&&e.target.closest(".mention-input [role=option]");if(!o){return;}` +
+		`e.preventDefault();select(o.closest(".mention-input").querySelector("textarea"),o);});` +
+		`document.addEventListener("focusout",function(e){if(e.target.hasAttribute&&e.target.hasAttribute("data-mention-url")){show(e.target,false);}});` +
+		`})();`,
+}
@@ -0,0 +1,28 @@
+package templ_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestMentionInput(t *testing.T) {
+	b := new(bytes.Buffer)
+	if err := templ.NewMentionInput("comment", "Comment", "Thanks <b>@alice</b>", templ.URL("/users?limit=5&sort=name")).Render(context.Background(), b); err != nil {
+		t.Fatalf("failed to render: %v", err)
+	}
+	html, _, ok := strings.Cut(b.String(), "<script")
+	if !ok {
+		t.Error("expected the mention input script to be rendered")
+	}
+	expected := `<div class="mention-input"><label for="comment">Comment</label>` +
+		`<textarea id="comment" name="comment" aria-autocomplete="list" aria-expanded="false" aria-controls="comment-mentions" data-mention-url="/users?limit=5&amp;sort=name">Thanks &lt;b&gt;@alice&lt;/b&gt;</textarea>` +
+		`<ul id="comment-mentions" role="listbox" aria-label="Suggestions" hidden></ul></div>`
+	if diff := cmp.Diff(expected, html); diff != "" {
+		t.Error(diff)
+	}
+}
@@ -0,0 +1,90 @@
+package templ
+
+import (
+	"context"
+	"io"
+	"sync/atomic"
+	"time"
+)
+
+// RenderMetrics contains aggregate statistics of the components rendered using a context.
+//
+// Fields are updated atomically, use the Load methods to read values while rendering
+// may still be in progress.
+type RenderMetrics struct {
+	// ComponentCount is the number of components rendered, including nested components.
+	ComponentCount int64
+	// BytesWritten is the number of bytes written by top-level components.
+	BytesWritten int64
+	// TotalDuration is the time spent rendering top-level components.
+	TotalDuration time.Duration
+}
+
+// LoadComponentCount atomically loads the ComponentCount.
+func (m *RenderMetrics) LoadComponentCount() int64 {
+	return atomic.LoadInt64(&m.ComponentCount)
+}
+
+// LoadBytesWritten atomically loads the BytesWritten.
+func (m *RenderMetrics) LoadBytesWritten() int64 {
+	return atomic.LoadInt64(&m.BytesWritten)
+}
+
+// LoadTotalDuration atomically loads the TotalDuration.
+func (m *RenderMetrics) LoadTotalDuration() time.Duration {
+	return time.Duration(atomic.LoadInt64((*int64)(&m.TotalDuration)))
+}
+
+type metricsContextKeyType int
+
+const metricsContextKey = metricsContextKeyType(0)
+
+type metricsContextValue struct {
+	metrics *RenderMetrics
+	// nested is true when a component is being rendered by another component.
+	nested bool
+}
+
+// WithMetrics attaches a new RenderMetrics to the context. Components rendered with the
+// returned context update the metrics, which can be read using GetMetrics.
+func WithMetrics(ctx context.Context) context.Context {
+	renderHooksEnabled.Store(true)
+	return context.WithValue(ctx, metricsContextKey, metricsContextValue{metrics: &RenderMetrics{}})
+}
+
+// GetMetrics returns the RenderMetrics attached to the context by WithMetrics.
+func GetMetrics(ctx context.Context) (m *RenderMetrics, ok bool) {
+	v, ok := ctx.Value(metricsContextKey).(metricsContextValue)
+	if !ok {
+		return nil, false
+	}
+	return v.metrics, true
+}
+
+// renderWithMetrics renders the component function, recording metrics.
+// Bytes written and duration are only recorded for top-level components,
+// because nested components write to the same output.
+func renderWithMetrics(ctx context.Context, w io.Writer, v metricsContextValue, cf ComponentFunc) error {
+	atomic.AddInt64(&v.metrics.ComponentCount, 1)
+	if v.nested {
+		return cf(ctx, w)
+	}
+	ctx = context.WithValue(ctx, metricsContextKey, metricsContextValue{metrics: v.metrics, nested: true})
+	cw := &countingWriter{w: w}
+	start := time.Now()
+	err := cf(ctx, cw)
+	atomic.AddInt64((*int64)(&v.metrics.TotalDuration), int64(time.Since(start)))
+	atomic.AddInt64(&v.metrics.BytesWritten, cw.n)
+	return err
+}
+
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (cw *countingWriter) Write(p []byte) (n int, err error) {
+	n, err = cw.w.Write(p)
+	cw.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,73 @@
+package templ_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/a-h/templ"
+)
+
+func TestMetrics(t *testing.T) {
+	child := templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+		_, err := io.WriteString(w, "child")
+		return err
+	})
+	parent := templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+		if _, err := io.WriteString(w, "<div>"); err != nil {
+			return err
+		}
+		for i := 0; i < 2; i++ {
+			if err := child.Render(ctx, w); err != nil {
+				return err
+			}
+		}
+		_, err := io.WriteString(w, "</div>")
+		return err
+	})
+
+	t.Run("metrics are not available unless attached", func(t *testing.T) {
+		if _, ok := templ.GetMetrics(context.Background()); ok {
+			t.Error("expected no metrics")
+		}
+	})
+	t.Run("nested components are counted, but bytes are only counted once", func(t *testing.T) {
+		ctx := templ.WithMetrics(context.Background())
+		b := new(bytes.Buffer)
+		if err := parent.Render(ctx, b); err != nil {
+			t.Fatalf("failed to render: %v", err)
+		}
+		if err := child.Render(ctx, b); err != nil {
+			t.Fatalf("failed to render: %v", err)
+		}
+		m, ok := templ.GetMetrics(ctx)
+		if !ok {
+			t.Fatal("expected metrics to be attached")
+		}
+		if m.LoadComponentCount() != 4 {
+			t.Errorf("expected 4 components, got %d", m.LoadComponentCount())
+		}
+		if m.LoadBytesWritten() != int64(b.Len()) {
+			t.Errorf("expected %d bytes, got %d", b.Len(), m.LoadBytesWritten())
+		}
+		if m.LoadTotalDuration() <= 0 {
+			t.Errorf("expected a positive duration, got %v", m.LoadTotalDuration())
+		}
+	})
+	t.Run("render errors are returned", func(t *testing.T) {
+		ctx := templ.WithMetrics(context.Background())
+		expectedErr := errors.New("render error")
+		err := templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+			return expectedErr
+		}).Render(ctx, io.Discard)
+		if err != expectedErr {
+			t.Errorf("expected %v, got %v", expectedErr, err)
+		}
+		m, _ := templ.GetMetrics(ctx)
+		if m.LoadComponentCount() != 1 {
+			t.Errorf("expected 1 component, got %d", m.LoadComponentCount())
+		}
+	})
+}
@@ -0,0 +1,117 @@
+package templ
+
+import (
+	"context"
+	"io"
+	"strconv"
+	"time"
+)
+
+// Notification is an in-app notification.
+type Notification struct {
+	ID    string
+	Title string
+	Body  string
+	Read  bool
+	Time  time.Time
+}
+
+// NotificationCenterOption configures a notification center.
+type NotificationCenterOption func(*notificationCenterConfig)
+
+type notificationCenterConfig struct {
+	markAsReadURL SafeURL
+}
+
+// WithMarkAsReadURL sets the endpoint that's called when a notification is marked as read.
+// The notification's ID is posted in the id form field.
+func WithMarkAsReadURL(url SafeURL) NotificationCenterOption {
+	return func(c *notificationCenterConfig) {
+		c.markAsReadURL = url
+	}
+}
+
+// NewNotificationCenter renders a bell button, with a badge containing the number of unread
+// notifications, which opens a dropdown list of the notifications.
+func NewNotificationCenter(notifications []Notification, opts ...NotificationCenterOption) Component {
+	var config notificationCenterConfig
+	for _, o := range opts {
+		o(&config)
+	}
+	var unread int
+	for _, n := range notifications {
+		if !n.Read {
+			unread++
+		}
+	}
+	trigger := ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		count := strconv.Itoa(unread)
+		if err = writeStrings(w, `<span aria-hidden="true">🔔</span><span class="notification-label">Notifications, `, count, ` unread</span>`,
+			`<span class="notification-badge" aria-hidden="true" data-unread-count`); err != nil {
+			return err
+		}
+		if unread == 0 {
+			if _, err = io.WriteString(w, ` hidden`); err != nil {
+				return err
+			}
+		}
+		return writeStrings(w, `>`, count, `</span>`)
+	})
+	list := ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		if err = writeStrings(w, `<ul class="notification-list" data-mark-as-read-url="`, EscapeString(string(config.markAsReadURL)), `">`); err != nil {
+			return err
+		}
+		if len(notifications) == 0 {
+			if _, err = io.WriteString(w, `<li>No notifications</li>`); err != nil {
+				return err
+			}
+		}
+		for _, n := range notifications {
+			class := "notification"
+			if !n.Read {
+				class += " unread"
+			}
+			if err = writeStrings(w,
+				`<li class="`, class, `" data-notification-id="`, EscapeString(n.ID), `">`,
+				`<strong>`, EscapeString(n.Title), `</strong><p>`, EscapeString(n.Body), `</p>`,
+				`<time datetime="`, n.Time.Format(time.RFC3339), `">`, n.Time.Format("2 Jan 2006 15:04"), `</time>`); err != nil {
+				return err
+			}
+			if !n.Read {
+				if _, err = io.WriteString(w, `<button type="button" data-mark-as-read>Mark as read</button>`); err != nil {
+					return err
+				}
+			}
+			if _, err = io.WriteString(w, `</li>`); err != nil {
+				return err
+			}
+		}
+		_, err = io.WriteString(w, `</ul>`)
+		return err
+	})
+	return ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		if _, err = io.WriteString(w, `<div class="notification-center">`); err != nil {
+			return err
+		}
+		if err = NewDropdown("notification-center-list", trigger, list).Render(ctx, w); err != nil {
+			return err
+		}
+		if _, err = io.WriteString(w, `</div>`); err != nil {
+			return err
+		}
+		return notificationCenterScript.Render(ctx, w)
+	})
+}
+
+var notificationCenterScript = ComponentScript{
+	Name: `__templ_notificationCenter`,
+	Function: `document.addEventListener("click",function(e){` +
+		`var button=e.target.closest&&e.target.closest("[data-mark-as-read]");if(!button){return;}` +
+		`var item=button.closest("[data-notification-id]");var list=item.closest(".notification-list");var url=list.getAttribute("data-mark-as-read-url");` +
+		`if(url){var body=new FormData();body.set("id",item.getAttribute("data-notification-id"));fetch(url,{method:"POST",body:body});}` +
+		`item.classList.remove("unread");button.remove();` +
+		`var center=list.closest(".notification-center");var count=list.querySelectorAll(".notification.unread").length;` +
+		`var badge=center.querySelector("[data-unread-count]");badge.textContent=String(count);badge.hidden=count===0;` +
+		`center.querySelector(".notification-label").textContent="Notifications, "+count+" unread";` +
+		`});`,
+}
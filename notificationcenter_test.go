@@ -0,0 +1,72 @@
+package templ_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/a-h/templ"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestNotificationCenter(t *testing.T) {
+	notifications := []templ.Notification{
+		{ID: "1", Title: "New comment", Body: "<b>Bob</b> replied", Time: time.Date(2024, time.March, 1, 9, 30, 0, 0, time.UTC)},
+		{ID: "2", Title: "Welcome", Body: "Thanks for joining", Read: true, Time: time.Date(2024, time.February, 1, 8, 0, 0, 0, time.UTC)},
+	}
+	tests := []struct {
+		name     string
+		input    templ.Component
+		expected string
+	}{
+		{
+			name:  "unread notifications are counted and can be marked as read",
+			input: templ.NewNotificationCenter(notifications, templ.WithMarkAsReadURL("/notifications/read")),
+			expected: `<div class="notification-center"><div class="dropdown" data-dropdown>` +
+				`<button type="button" aria-haspopup="true" aria-expanded="false" aria-controls="notification-center-list">` +
+				`<span aria-hidden="true">🔔</span><span class="notification-label">Notifications, 1 unread</span>` +
+				`<span class="notification-badge" aria-hidden="true" data-unread-count>1</span></button>` +
+				`<div id="notification-center-list" class="dropdown-content" hidden><ul class="notification-list" data-mark-as-read-url="/notifications/read">` +
+				`<li class="notification unread" data-notification-id="1"><strong>New comment</strong><p>&lt;b&gt;Bob&lt;/b&gt; replied</p>` +
+				`<time datetime="2024-03-01T09:30:00Z">1 Mar 2024 09:30</time><button type="button" data-mark-as-read>Mark as read</button></li>` +
+				`<li class="notification" data-notification-id="2"><strong>Welcome</strong><p>Thanks for joining</p>` +
+				`<time datetime="2024-02-01T08:00:00Z">1 Feb 2024 08:00</time></li>` +
+				`</ul></div></div></div>`,
+		},
+		{
+			name:  "the badge is hidden when there are no notifications",
+			input: templ.NewNotificationCenter(nil),
+			expected: `<div class="notification-center"><div class="dropdown" data-dropdown>` +
+				`<button type="button" aria-haspopup="true" aria-expanded="false" aria-controls="notification-center-list">` +
+				`<span aria-hidden="true">🔔</span><span class="notification-label">Notifications, 0 unread</span>` +
+				`<span class="notification-badge" aria-hidden="true" data-unread-count hidden>0</span></button>` +
+				`<div id="notification-center-list" class="dropdown-content" hidden><ul class="notification-list" data-mark-as-read-url="">` +
+				`<li>No notifications</li></ul></div></div></div>`,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			b := new(bytes.Buffer)
+			if err := tt.input.Render(context.Background(), b); err != nil {
+				t.Fatalf("failed to render: %v", err)
+			}
+			// Remove the dropdown and notification center scripts.
+			var html strings.Builder
+			rest := b.String()
+			for {
+				before, after, ok := strings.Cut(rest, "<script")
+				html.WriteString(before)
+				if !ok {
+					break
+				}
+				_, rest, _ = strings.Cut(after, "</script>")
+			}
+			if diff := cmp.Diff(tt.expected, html.String()); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+// Package oteltrace adds OpenTelemetry tracing to templ component handlers.
+//
+// It's a separate module, so that OpenTelemetry is not a dependency of templ.
+package oteltrace
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"reflect"
+	"runtime"
+	"strings"
+
+	"github.com/a-h/templ"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// SpanName is the name of the span created for each render.
+	SpanName = "templ.render"
+	// ComponentNameKey is the span attribute containing the name of the rendered component.
+	ComponentNameKey = attribute.Key("component.name")
+	// HTTPRouteKey is the span attribute containing the route of the request.
+	HTTPRouteKey = attribute.Key("http.route")
+
+	instrumentationName = "github.com/a-h/templ/oteltrace"
+)
+
+// WithTracing creates a child span named "templ.render" for each render of the ComponentHandler's
+// component. If rendering fails, the error is recorded on the span.
+//
+// The span is started from the request context, so the span is a child of any span created by
+// HTTP instrumentation, e.g. otelhttp.
+func WithTracing(tp trace.TracerProvider) func(*templ.ComponentHandler) {
+	return func(ch *templ.ComponentHandler) {
+		ch.Component = tracedComponent{
+			tracer: tp.Tracer(instrumentationName),
+			name:   componentName(ch.Component),
+			next:   ch.Component,
+		}
+	}
+}
+
+type tracedComponent struct {
+	tracer trace.Tracer
+	name   string
+	next   templ.Component
+}
+
+func (tc tracedComponent) Render(ctx context.Context, w io.Writer) (err error) {
+	attrs := []attribute.KeyValue{ComponentNameKey.String(tc.name)}
+	if route, ok := ctx.Value(routeContextKey).(string); ok {
+		attrs = append(attrs, HTTPRouteKey.String(route))
+	}
+	ctx, span := tc.tracer.Start(ctx, SpanName, trace.WithAttributes(attrs...))
+	defer span.End()
+	if err = tc.next.Render(ctx, w); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return err
+}
+
+type contextKeyType int
+
+const routeContextKey = contextKeyType(0)
+
+// WithRoute sets the route recorded in the http.route attribute of render spans.
+//
+// The ComponentHandler does not know which route it's mounted at, so the route must
+// be provided by the router, or by middleware.
+func WithRoute(ctx context.Context, route string) context.Context {
+	return context.WithValue(ctx, routeContextKey, route)
+}
+
+// componentName returns the name of the function that created the component
+// for components created with templ.ComponentFunc, e.g. generated components,
+// otherwise, the type of the component.
+func componentName(c templ.Component) string {
+	if cf, ok := c.(templ.ComponentFunc); ok {
+		if f := runtime.FuncForPC(reflect.ValueOf(cf).Pointer()); f != nil {
+			return strings.TrimSuffix(f.Name(), ".func1")
+		}
+	}
+	return fmt.Sprintf("%T", c)
+}
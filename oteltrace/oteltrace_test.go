@@ -0,0 +1,110 @@
+package oteltrace
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/a-h/templ"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func hello() templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+		_, err := io.WriteString(w, "Hello")
+		return err
+	})
+}
+
+type staticComponent string
+
+func (sc staticComponent) Render(ctx context.Context, w io.Writer) error {
+	_, err := io.WriteString(w, string(sc))
+	return err
+}
+
+func TestWithTracing(t *testing.T) {
+	tests := []struct {
+		name           string
+		component      templ.Component
+		route          string
+		expectedName   string
+		expectedStatus codes.Code
+		expectedBody   string
+	}{
+		{
+			name:           "successful renders create a span",
+			component:      hello(),
+			expectedName:   "github.com/a-h/templ/oteltrace.hello",
+			expectedStatus: codes.Unset,
+			expectedBody:   "Hello",
+		},
+		{
+			name:           "the route is recorded if set",
+			component:      hello(),
+			route:          "/hello/{name}",
+			expectedName:   "github.com/a-h/templ/oteltrace.hello",
+			expectedStatus: codes.Unset,
+			expectedBody:   "Hello",
+		},
+		{
+			name:           "failed renders record the error",
+			component:      templ.Raw("", errors.New("render error")),
+			expectedName:   "github.com/a-h/templ.Raw[...]",
+			expectedStatus: codes.Error,
+			expectedBody:   "templ: failed to render template\n",
+		},
+		{
+			name:           "components that are not functions use the type name",
+			component:      staticComponent("Static"),
+			expectedName:   "oteltrace.staticComponent",
+			expectedStatus: codes.Unset,
+			expectedBody:   "Static",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			sr := tracetest.NewSpanRecorder()
+			tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sr))
+
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest(http.MethodGet, "/hello/world", nil)
+			if tt.route != "" {
+				r = r.WithContext(WithRoute(r.Context(), tt.route))
+			}
+			templ.Handler(tt.component, WithTracing(tp)).ServeHTTP(w, r)
+
+			if w.Body.String() != tt.expectedBody {
+				t.Errorf("expected body %q, got %q", tt.expectedBody, w.Body.String())
+			}
+			spans := sr.Ended()
+			if len(spans) != 1 {
+				t.Fatalf("expected 1 span, got %d", len(spans))
+			}
+			span := spans[0]
+			if span.Name() != SpanName {
+				t.Errorf("expected span name %q, got %q", SpanName, span.Name())
+			}
+			if span.Status().Code != tt.expectedStatus {
+				t.Errorf("expected status %v, got %v", tt.expectedStatus, span.Status().Code)
+			}
+			attrs := map[attribute.Key]string{}
+			for _, kv := range span.Attributes() {
+				attrs[kv.Key] = kv.Value.AsString()
+			}
+			if attrs[ComponentNameKey] != tt.expectedName {
+				t.Errorf("expected component name %q, got %q", tt.expectedName, attrs[ComponentNameKey])
+			}
+			if route, ok := attrs[HTTPRouteKey]; ok != (tt.route != "") || route != tt.route {
+				t.Errorf("expected route %q, got %q", tt.route, route)
+			}
+		})
+	}
+}
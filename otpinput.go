@@ -0,0 +1,56 @@
+package templ
+
+import (
+	"context"
+	"io"
+	"strconv"
+)
+
+// NewOTPInput renders a one-time password input of length single character inputs.
+// The characters are combined into a hidden input with the given name for submission.
+//
+// Focus advances to the next input as each character is entered, and pasting a code
+// fills all of the inputs.
+func NewOTPInput(name, label string, length int) Component {
+	return ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		id := EscapeString(name)
+		if err = writeStrings(w,
+			`<fieldset class="otp-input" data-otp-input>`,
+			`<legend>`, EscapeString(label), `</legend>`,
+			`<input type="hidden" id="`, id, `" name="`, id, `" value="">`); err != nil {
+			return err
+		}
+		n := strconv.Itoa(length)
+		for i := 1; i <= length; i++ {
+			autocomplete := "off"
+			if i == 1 {
+				autocomplete = "one-time-code"
+			}
+			if err = writeStrings(w,
+				`<input type="text" inputmode="numeric" maxlength="1" autocomplete="`, autocomplete, `" aria-label="Character `, strconv.Itoa(i), ` of `, n, `" data-otp-digit>`); err != nil {
+				return err
+			}
+		}
+		if _, err = io.WriteString(w, `</fieldset>`); err != nil {
+			return err
+		}
+		return otpInputScript.Render(ctx, w)
+	})
+}
+
+var otpInputScript = ComponentScript{
+	Name: `__templ_otpInput`,
+	Function: `(function(){` +
+		`function digits(input){var group=input.closest("[data-otp-input]");return group?Array.prototype.slice.call(group.querySelectorAll("[data-otp-digit]")):[];}` +
+		`function sync(input){var group=input.closest("[data-otp-input]");group.querySelector("input[type=hidden]").value=digits(input).map(function(d){return d.value;}).join("");}` +
+		`function fill(input,value){var inputs=digits(input);var start=inputs.indexOf(input);` +
+		`for(var i=0;i<value.length&&start+i<inputs.length;i++){inputs[start+i].value=value[i];}` +
+		`var next=inputs[Math.min(start+value.length,inputs.length-1)];next.focus();sync(input);}` +
+		`document.addEventListener("input",function(e){var input=e.target;if(!input.hasAttribute||!input.hasAttribute("data-otp-digit")){return;}` +
+		`var value=input.value;input.value="";if(value){fill(input,value);}else{sync(input);}});` +
+		`document.addEventListener("keydown",function(e){var input=e.target;if(e.key!=="Backspace"||!input.hasAttribute||!input.hasAttribute("data-otp-digit")||input.value){return;}` +
+		`var inputs=digits(input);var prev=inputs[inputs.indexOf(input)-1];if(prev){prev.value="";prev.focus();sync(input);e.preventDefault();}});` +
+		`document.addEventListener("paste",function(e){var input=e.target;if(!input.hasAttribute||!input.hasAttribute("data-otp-digit")){return;}` +
+		`e.preventDefault();fill(input,(e.clipboardData||window.clipboardData).getData("text").replace(/\s+/g,""));});` +
+		`})();`,
+}
@@ -0,0 +1,31 @@
+package templ_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestOTPInput(t *testing.T) {
+	b := new(bytes.Buffer)
+	if err := templ.NewOTPInput("code", "Verification code", 3).Render(context.Background(), b); err != nil {
+		t.Fatalf("failed to render: %v", err)
+	}
+	html, script, ok := strings.Cut(b.String(), "<script")
+	if !ok || !strings.Contains(script, "data-otp-digit") {
+		t.Error("expected the OTP input script to be rendered")
+	}
+	expected := `<fieldset class="otp-input" data-otp-input><legend>Verification code</legend>` +
+		`<input type="hidden" id="code" name="code" value="">` +
+		`<input type="text" inputmode="numeric" maxlength="1" autocomplete="one-time-code" aria-label="Character 1 of 3" data-otp-digit>` +
+		`<input type="text" inputmode="numeric" maxlength="1" autocomplete="off" aria-label="Character 2 of 3" data-otp-digit>` +
+		`<input type="text" inputmode="numeric" maxlength="1" autocomplete="off" aria-label="Character 3 of 3" data-otp-digit>` +
+		`</fieldset>`
+	if diff := cmp.Diff(expected, html); diff != "" {
+		t.Error(diff)
+	}
+}
@@ -0,0 +1,54 @@
+package templ
+
+import (
+	"context"
+	"io"
+	"math"
+	"strconv"
+)
+
+var parallaxClass = newComponentCSSRules("parallax",
+	`&{background-size:cover;background-repeat:no-repeat;background-position:center 0;}`+
+		`@media (prefers-reduced-motion:reduce){&{background-position:center;}}`)
+
+// NewParallax renders the body in a section with a background image that scrolls at a
+// different rate to the page. A speed of 0 keeps the background in place, 1 scrolls it with
+// the page, and 0.5 scrolls it at half the rate of the page.
+//
+// The background URL is sanitized with SanitizeCSSURL.
+func NewParallax(background SafeURL, speed float64, body Component) Component {
+	return ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		s := speed
+		if math.IsNaN(s) || math.IsInf(s, 0) {
+			s = 0
+		}
+		if err = RenderCSSItems(ctx, w, parallaxClass); err != nil {
+			return err
+		}
+		style := "background-image:" + string(SanitizeCSSURL(string(background))) + ";"
+		if err = writeStrings(w, `<section class="parallax `, parallaxClass.ID, `" data-parallax data-speed="`, strconv.FormatFloat(s, 'f', -1, 64), `" style="`, EscapeString(style), `">`); err != nil {
+			return err
+		}
+		if err = body.Render(ctx, w); err != nil {
+			return err
+		}
+		if _, err = io.WriteString(w, `</section>`); err != nil {
+			return err
+		}
+		return parallaxScript.Render(ctx, w)
+	})
+}
+
+var parallaxScript = ComponentScript{
+	Name: `__templ_parallax`,
+	// The position is updated once per frame, however many scroll events there are.
+	Function: `(function(){` +
+		`if(window.matchMedia&&window.matchMedia("(prefers-reduced-motion: reduce)").matches){return;}` +
+		`var pending=false;` +
+		`function update(){pending=false;document.querySelectorAll("[data-parallax]").forEach(function(el){` +
+		`var speed=parseFloat(el.getAttribute("data-speed"))||0;` +
+		`el.style.backgroundPosition="center "+(-el.getBoundingClientRect().top*(1-speed))+"px";});}` +
+		`window.addEventListener("scroll",function(){if(!pending){pending=true;window.requestAnimationFrame(update);}},{passive:true});` +
+		`update();` +
+		`})();`,
+}
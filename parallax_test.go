@@ -0,0 +1,70 @@
+package templ_test
+
+import (
+	"bytes"
+	"context"
+	"math"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/google/go-cmp/cmp"
+)
+
+var parallaxClassPattern = regexp.MustCompile(`parallax_[0-9a-f]{4}`)
+
+func TestParallax(t *testing.T) {
+	tests := []struct {
+		name       string
+		background templ.SafeURL
+		speed      float64
+		expected   string
+	}{
+		{
+			name:       "the background is rendered as a CSS url",
+			background: "/img/hero.jpg",
+			speed:      0.5,
+			expected:   `<section class="parallax parallax" data-parallax data-speed="0.5" style="background-image:url(&#34;/img/hero.jpg&#34;);"><h1>Hello</h1></section>`,
+		},
+		{
+			name:       "unsafe URLs are replaced",
+			background: "javascript:alert(1)",
+			speed:      0.5,
+			expected:   `<section class="parallax parallax" data-parallax data-speed="0.5" style="background-image:url(&#34;about:invalid#TemplFailedSanitizationURL&#34;);"><h1>Hello</h1></section>`,
+		},
+		{
+			name:       "quotes in the URL are escaped",
+			background: `/img/a".jpg`,
+			speed:      1,
+			expected:   `<section class="parallax parallax" data-parallax data-speed="1" style="background-image:url(&#34;/img/a\&#34;.jpg&#34;);"><h1>Hello</h1></section>`,
+		},
+		{
+			name:       "invalid speeds are replaced with 0",
+			background: "/img/hero.jpg",
+			speed:      math.NaN(),
+			expected:   `<section class="parallax parallax" data-parallax data-speed="0" style="background-image:url(&#34;/img/hero.jpg&#34;);"><h1>Hello</h1></section>`,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			b := new(bytes.Buffer)
+			if err := templ.NewParallax(tt.background, tt.speed, templ.Raw(`<h1>Hello</h1>`)).Render(context.Background(), b); err != nil {
+				t.Fatalf("failed to render: %v", err)
+			}
+			output := parallaxClassPattern.ReplaceAllString(b.String(), "parallax")
+			style, rest, ok := strings.Cut(output, "</style>")
+			if !ok || !strings.Contains(style, ".parallax{") {
+				t.Errorf("expected the CSS to be rendered, got %q", style)
+			}
+			html, script, _ := strings.Cut(rest, "<script")
+			if diff := cmp.Diff(tt.expected, html); diff != "" {
+				t.Error(diff)
+			}
+			if !strings.Contains(script, "requestAnimationFrame") {
+				t.Errorf("expected the script to be rendered, got %q", script)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package templ
+
+import (
+	"context"
+	"io"
+)
+
+// NewPasswordInput renders a labelled password input, with a button that shows or hides
+// the password.
+func NewPasswordInput(name, label, placeholder string) Component {
+	return ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		id := EscapeString(name)
+		if err = writeStrings(w,
+			`<div class="password-input">`,
+			`<label for="`, id, `">`, EscapeString(label), `</label>`,
+			`<input type="password" id="`, id, `" name="`, id, `" placeholder="`, EscapeString(placeholder), `" autocomplete="current-password">`,
+			`<button type="button" aria-label="Show password" aria-pressed="false" aria-controls="`, id, `" data-password-toggle>Show</button>`,
+			`</div>`); err != nil {
+			return err
+		}
+		return passwordInputScript.Render(ctx, w)
+	})
+}
+
+var passwordInputScript = ComponentScript{
+	Name: `__templ_passwordInput`,
+	Function: `document.addEventListener("click",function(e){` +
+		`var button=e.target.closest&&e.target.closest("[data-password-toggle]");if(!button){return;}` +
+		`var input=document.getElementById(button.getAttribute("aria-controls"));if(!input){return;}` +
+		`var show=input.type==="password";input.type=show?"text":"password";` +
+		`button.setAttribute("aria-pressed",String(show));` +
+		`button.setAttribute("aria-label",show?"Hide password":"Show password");` +
+		`button.textContent=show?"Hide":"Show";` +
+		`});`,
+}
@@ -0,0 +1,28 @@
+package templ_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPasswordInput(t *testing.T) {
+	b := new(bytes.Buffer)
+	if err := templ.NewPasswordInput("password", "Password", `Enter "password"`).Render(context.Background(), b); err != nil {
+		t.Fatalf("failed to render: %v", err)
+	}
+	expected := `<div class="password-input"><label for="password">Password</label>` +
+		`<input type="password" id="password" name="password" placeholder="Enter &#34;password&#34;" autocomplete="current-password">` +
+		`<button type="button" aria-label="Show password" aria-pressed="false" aria-controls="password" data-password-toggle>Show</button></div>`
+	actual, script, _ := strings.Cut(b.String(), "<script")
+	if diff := cmp.Diff(expected, actual); diff != "" {
+		t.Error(diff)
+	}
+	if script == "" {
+		t.Error("expected the script to be rendered")
+	}
+}
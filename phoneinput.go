@@ -0,0 +1,67 @@
+package templ
+
+import (
+	"context"
+	"io"
+)
+
+// PhoneInputOption configures a phone input.
+type PhoneInputOption func(*phoneInputConfig)
+
+type phoneInputConfig struct {
+	format string
+}
+
+// WithPhoneFormat sets the format applied to the phone number as the user types.
+// Each # in the format is replaced by a digit, e.g. "(###) ###-####".
+func WithPhoneFormat(format string) PhoneInputOption {
+	return func(c *phoneInputConfig) {
+		c.format = format
+	}
+}
+
+// NewPhoneInput renders a labelled telephone number input. The pattern is used by the
+// browser to validate the input, and is omitted if empty.
+func NewPhoneInput(name, label, value string, pattern string, opts ...PhoneInputOption) Component {
+	var config phoneInputConfig
+	for _, o := range opts {
+		o(&config)
+	}
+	return ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		id := EscapeString(name)
+		if err = writeStrings(w,
+			`<div class="phone-input">`,
+			`<label for="`, id, `">`, EscapeString(label), `</label>`,
+			`<input type="tel" id="`, id, `" name="`, id, `" value="`, EscapeString(value), `" autocomplete="tel" inputmode="tel"`); err != nil {
+			return err
+		}
+		if pattern != "" {
+			if err = writeStrings(w, ` pattern="`, EscapeString(pattern), `"`); err != nil {
+				return err
+			}
+		}
+		if config.format != "" {
+			if err = writeStrings(w, ` data-format="`, EscapeString(config.format), `"`); err != nil {
+				return err
+			}
+		}
+		if _, err = io.WriteString(w, `></div>`); err != nil {
+			return err
+		}
+		if config.format == "" {
+			return nil
+		}
+		return phoneInputScript.Render(ctx, w)
+	})
+}
+
+var phoneInputScript = ComponentScript{
+	Name: `__templ_phoneInput`,
+	Function: `document.addEventListener("input",function(e){` +
+		`var input=e.target;var format=input.getAttribute&&input.getAttribute("data-format");if(!format||input.type!=="tel"){return;}` +
+		`if(e.inputType&&e.inputType.indexOf("delete")===0){return;}` +
+		`var digits=input.value.replace(/\D/g,"");var out="";var i=0;` +
+		`for(var j=0;j<format.length&&i<digits.length;j++){out+=format[j]==="#"?digits[i++]:format[j];}` +
+		`input.value=out+digits.slice(i);` +
+		`});`,
+}
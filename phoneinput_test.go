@@ -0,0 +1,50 @@
+package templ_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPhoneInput(t *testing.T) {
+	tests := []struct {
+		name           string
+		input          templ.Component
+		expected       string
+		expectedScript bool
+	}{
+		{
+			name:  "without a pattern or format",
+			input: templ.NewPhoneInput("phone", "Phone", "", ""),
+			expected: `<div class="phone-input"><label for="phone">Phone</label>` +
+				`<input type="tel" id="phone" name="phone" value="" autocomplete="tel" inputmode="tel"></div>`,
+		},
+		{
+			name:  "with a pattern and format",
+			input: templ.NewPhoneInput("phone", "Phone", "5551234567", `\(\d{3}\) \d{3}-\d{4}`, templ.WithPhoneFormat("(###) ###-####")),
+			expected: `<div class="phone-input"><label for="phone">Phone</label>` +
+				`<input type="tel" id="phone" name="phone" value="5551234567" autocomplete="tel" inputmode="tel" pattern="\(\d{3}\) \d{3}-\d{4}" data-format="(###) ###-####"></div>`,
+			expectedScript: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			b := new(bytes.Buffer)
+			if err := tt.input.Render(context.Background(), b); err != nil {
+				t.Fatalf("failed to render: %v", err)
+			}
+			actual, _, hasScript := strings.Cut(b.String(), "<script")
+			if diff := cmp.Diff(tt.expected, actual); diff != "" {
+				t.Error(diff)
+			}
+			if hasScript != tt.expectedScript {
+				t.Errorf("expected script %v, got %v", tt.expectedScript, hasScript)
+			}
+		})
+	}
+}
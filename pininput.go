@@ -0,0 +1,83 @@
+package templ
+
+import (
+	"context"
+	"io"
+	"strconv"
+)
+
+// PinInputOption configures a PIN input.
+type PinInputOption func(*pinInputConfig)
+
+type pinInputConfig struct {
+	reveal bool
+}
+
+// WithReveal adds a button that shows or hides the PIN.
+func WithReveal(reveal bool) PinInputOption {
+	return func(c *pinInputConfig) {
+		c.reveal = reveal
+	}
+}
+
+var pinInputClass = newComponentCSSRules("pinInput",
+	`&{display:inline-flex;gap:0.5em;border:none;padding:0;}`+
+		`& input[data-otp-digit]{width:2em;height:2em;text-align:center;font-size:1.25em;border:1px solid #ccc;border-radius:0.25em;}`+
+		`& input[data-otp-digit]::placeholder{color:#ccc;}`+
+		`& input[data-otp-digit]:focus{outline:2px solid #2563eb;outline-offset:1px;}`)
+
+// NewPinInput renders a PIN input of length masked digit inputs. Empty digits are shown
+// as dots. The digits are combined into a hidden input with the given name for submission.
+//
+// Focus advances and pasting is handled in the same way as NewOTPInput.
+func NewPinInput(name string, length int, opts ...PinInputOption) Component {
+	var config pinInputConfig
+	for _, o := range opts {
+		o(&config)
+	}
+	return ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		if err = RenderCSSItems(ctx, w, pinInputClass); err != nil {
+			return err
+		}
+		id := EscapeString(name)
+		if err = writeStrings(w,
+			`<fieldset class="pin-input `, pinInputClass.ID, `" data-otp-input>`,
+			`<input type="hidden" id="`, id, `" name="`, id, `" value="">`); err != nil {
+			return err
+		}
+		n := strconv.Itoa(length)
+		for i := 1; i <= length; i++ {
+			if err = writeStrings(w,
+				`<input type="password" inputmode="numeric" pattern="[0-9]" maxlength="1" autocomplete="off" placeholder="•" aria-label="Digit `, strconv.Itoa(i), ` of `, n, `" data-otp-digit>`); err != nil {
+				return err
+			}
+		}
+		if config.reveal {
+			if _, err = io.WriteString(w, `<button type="button" aria-label="Show PIN" aria-pressed="false" data-pin-reveal>Show</button>`); err != nil {
+				return err
+			}
+		}
+		if _, err = io.WriteString(w, `</fieldset>`); err != nil {
+			return err
+		}
+		if err = otpInputScript.Render(ctx, w); err != nil {
+			return err
+		}
+		if !config.reveal {
+			return nil
+		}
+		return pinInputRevealScript.Render(ctx, w)
+	})
+}
+
+var pinInputRevealScript = ComponentScript{
+	Name: `__templ_pinInputReveal`,
+	Function: `document.addEventListener("click",function(e){` +
+		`var button=e.target.closest&&e.target.closest("[data-pin-reveal]");if(!button){return;}` +
+		`var show=button.getAttribute("aria-pressed")!=="true";` +
+		`button.closest("[data-otp-input]").querySelectorAll("[data-otp-digit]").forEach(function(input){input.type=show?"text":"password";});` +
+		`button.setAttribute("aria-pressed",String(show));` +
+		`button.setAttribute("aria-label",show?"Hide PIN":"Show PIN");` +
+		`button.textContent=show?"Hide":"Show";` +
+		`});`,
+}
@@ -0,0 +1,63 @@
+package templ_test
+
+import (
+	"bytes"
+	"context"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/google/go-cmp/cmp"
+)
+
+var pinInputClassPattern = regexp.MustCompile(`pinInput_[0-9a-f]{4}`)
+
+func TestPinInput(t *testing.T) {
+	digit := func(i string) string {
+		return `<input type="password" inputmode="numeric" pattern="[0-9]" maxlength="1" autocomplete="off" placeholder="•" aria-label="Digit ` + i + ` of 2" data-otp-digit>`
+	}
+	tests := []struct {
+		name            string
+		input           templ.Component
+		expected        string
+		expectedScripts int
+	}{
+		{
+			name:  "without reveal",
+			input: templ.NewPinInput("pin", 2),
+			expected: `<fieldset class="pin-input pinInput" data-otp-input><input type="hidden" id="pin" name="pin" value="">` +
+				digit("1") + digit("2") + `</fieldset>`,
+			expectedScripts: 1,
+		},
+		{
+			name:  "with reveal",
+			input: templ.NewPinInput("pin", 2, templ.WithReveal(true)),
+			expected: `<fieldset class="pin-input pinInput" data-otp-input><input type="hidden" id="pin" name="pin" value="">` +
+				digit("1") + digit("2") +
+				`<button type="button" aria-label="Show PIN" aria-pressed="false" data-pin-reveal>Show</button></fieldset>`,
+			expectedScripts: 2,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			b := new(bytes.Buffer)
+			if err := tt.input.Render(context.Background(), b); err != nil {
+				t.Fatalf("failed to render: %v", err)
+			}
+			output := pinInputClassPattern.ReplaceAllString(b.String(), "pinInput")
+			style, rest, ok := strings.Cut(output, "</style>")
+			if !ok || !strings.Contains(style, ".pinInput input[data-otp-digit]") {
+				t.Errorf("expected the CSS to be rendered, got %q", style)
+			}
+			html, _, _ := strings.Cut(rest, "<script")
+			if diff := cmp.Diff(tt.expected, html); diff != "" {
+				t.Error(diff)
+			}
+			if count := strings.Count(rest, "<script"); count != tt.expectedScripts {
+				t.Errorf("expected %d scripts, got %d", tt.expectedScripts, count)
+			}
+		})
+	}
+}
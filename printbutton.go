@@ -0,0 +1,25 @@
+package templ
+
+import (
+	"context"
+	"io"
+)
+
+// printButtonClass hides the button when printing, and adds print-friendly page styles.
+var printButtonClass = newComponentCSSRules("printButton",
+	`@media print{`+
+		`&{display:none;}`+
+		`body{color:#000;background:#fff;}`+
+		`a[href^="http"]::after{content:" (" attr(href) ")";}`+
+		`}`)
+
+// NewPrintButton renders a button that opens the browser's print dialog. The button is
+// hidden in the printed page.
+func NewPrintButton(label string) Component {
+	return ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		if err = RenderCSSItems(ctx, w, printButtonClass); err != nil {
+			return err
+		}
+		return writeStrings(w, `<button type="button" onclick="`, EscapeString(SafeScript("window.print")), `" class="print-btn `, printButtonClass.ID, `">`, EscapeString(label), `</button>`)
+	})
+}
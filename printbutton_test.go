@@ -0,0 +1,30 @@
+package templ_test
+
+import (
+	"bytes"
+	"context"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/google/go-cmp/cmp"
+)
+
+var printButtonClassPattern = regexp.MustCompile(`printButton_[0-9a-f]{4}`)
+
+func TestPrintButton(t *testing.T) {
+	b := new(bytes.Buffer)
+	if err := templ.NewPrintButton("Print <receipt>").Render(context.Background(), b); err != nil {
+		t.Fatalf("failed to render: %v", err)
+	}
+	output := printButtonClassPattern.ReplaceAllString(b.String(), "printButton")
+	style, html, ok := strings.Cut(output, "</style>")
+	if !ok || !strings.Contains(style, "@media print{.printButton{display:none;}") {
+		t.Errorf("expected the CSS to be rendered, got %q", style)
+	}
+	expected := `<button type="button" onclick="window.print()" class="print-btn printButton">Print &lt;receipt&gt;</button>`
+	if diff := cmp.Diff(expected, html); diff != "" {
+		t.Error(diff)
+	}
+}
@@ -0,0 +1,40 @@
+package templ
+
+import (
+	"context"
+	"io"
+	"strconv"
+)
+
+// NewRangeSlider renders a labelled range input, and an output element that displays the
+// current value.
+func NewRangeSlider(name, label string, min, max, step, value float64) Component {
+	return ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		id := EscapeString(name)
+		minValue, maxValue, valueNow := formatFloat(min), formatFloat(max), formatFloat(value)
+		if err = writeStrings(w,
+			`<div class="range-slider">`,
+			`<label for="`, id, `">`, EscapeString(label), `</label>`,
+			`<input type="range" id="`, id, `" name="`, id,
+			`" min="`, minValue, `" max="`, maxValue, `" step="`, formatFloat(step), `" value="`, valueNow,
+			`" aria-valuemin="`, minValue, `" aria-valuemax="`, maxValue, `" aria-valuenow="`, valueNow, `">`,
+			`<output id="`, id, `-output" for="`, id, `">`, valueNow, `</output>`,
+			`</div>`); err != nil {
+			return err
+		}
+		return rangeSliderScript.Render(ctx, w)
+	})
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+var rangeSliderScript = ComponentScript{
+	Name: `__templ_rangeSlider`,
+	Function: `document.addEventListener("input",function(e){` +
+		`var input=e.target;if(!input.matches||!input.matches(".range-slider input[type=range]")){return;}` +
+		`input.setAttribute("aria-valuenow",input.value);` +
+		`var output=document.getElementById(input.id+"-output");if(output){output.value=input.value;}` +
+		`});`,
+}
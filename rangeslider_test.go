@@ -0,0 +1,28 @@
+package templ_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRangeSlider(t *testing.T) {
+	b := new(bytes.Buffer)
+	if err := templ.NewRangeSlider("volume", "Volume", 0, 1, 0.1, 0.5).Render(context.Background(), b); err != nil {
+		t.Fatalf("failed to render: %v", err)
+	}
+	expected := `<div class="range-slider"><label for="volume">Volume</label>` +
+		`<input type="range" id="volume" name="volume" min="0" max="1" step="0.1" value="0.5" aria-valuemin="0" aria-valuemax="1" aria-valuenow="0.5">` +
+		`<output id="volume-output" for="volume">0.5</output></div>`
+	actual, script, _ := strings.Cut(b.String(), "<script")
+	if diff := cmp.Diff(expected, actual); diff != "" {
+		t.Error(diff)
+	}
+	if script == "" {
+		t.Error("expected the script to be rendered")
+	}
+}
@@ -0,0 +1,65 @@
+package templ
+
+import (
+	"fmt"
+	"sync"
+)
+
+// ComponentRegistry maps names to components, so that components can be looked up
+// at runtime, e.g. by a CMS or plugin system.
+//
+// A ComponentRegistry is safe for concurrent use. Components are typically
+// registered during initialization, and looked up while serving requests.
+type ComponentRegistry struct {
+	m          sync.RWMutex
+	components map[string]Component
+}
+
+// NewComponentRegistry creates an empty ComponentRegistry.
+func NewComponentRegistry() *ComponentRegistry {
+	return &ComponentRegistry{
+		components: map[string]Component{},
+	}
+}
+
+// Register the component under the name. A component already registered under the
+// name is replaced.
+func (r *ComponentRegistry) Register(name string, c Component) {
+	r.m.Lock()
+	defer r.m.Unlock()
+	if r.components == nil {
+		r.components = map[string]Component{}
+	}
+	r.components[name] = c
+}
+
+// Lookup returns the component registered under the name, and whether it was found.
+func (r *ComponentRegistry) Lookup(name string) (c Component, ok bool) {
+	r.m.RLock()
+	defer r.m.RUnlock()
+	c, ok = r.components[name]
+	return c, ok
+}
+
+// MustLookup returns the component registered under the name, and panics if no
+// component is registered.
+func (r *ComponentRegistry) MustLookup(name string) Component {
+	c, ok := r.Lookup(name)
+	if !ok {
+		panic(fmt.Errorf("templ: no component registered with name %q", name))
+	}
+	return c
+}
+
+// DefaultRegistry is the ComponentRegistry used by Register and Lookup.
+var DefaultRegistry = NewComponentRegistry()
+
+// Register the component under the name in the DefaultRegistry.
+func Register(name string, c Component) {
+	DefaultRegistry.Register(name, c)
+}
+
+// Lookup returns the component registered under the name in the DefaultRegistry.
+func Lookup(name string) (Component, bool) {
+	return DefaultRegistry.Lookup(name)
+}
@@ -0,0 +1,92 @@
+package templ_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/a-h/templ"
+)
+
+func TestComponentRegistry(t *testing.T) {
+	t.Run("registered components can be looked up", func(t *testing.T) {
+		r := templ.NewComponentRegistry()
+		r.Register("hello", templ.Raw("Hello"))
+		c, ok := r.Lookup("hello")
+		if !ok {
+			t.Fatal("expected the component to be found")
+		}
+		b := new(bytes.Buffer)
+		if err := c.Render(context.Background(), b); err != nil {
+			t.Fatalf("failed to render: %v", err)
+		}
+		if b.String() != "Hello" {
+			t.Errorf("expected %q, got %q", "Hello", b.String())
+		}
+	})
+	t.Run("registering a name again replaces the component", func(t *testing.T) {
+		r := templ.NewComponentRegistry()
+		r.Register("hello", templ.Raw("Hello"))
+		r.Register("hello", templ.Raw("Hi"))
+		b := new(bytes.Buffer)
+		if err := r.MustLookup("hello").Render(context.Background(), b); err != nil {
+			t.Fatalf("failed to render: %v", err)
+		}
+		if b.String() != "Hi" {
+			t.Errorf("expected %q, got %q", "Hi", b.String())
+		}
+	})
+	t.Run("unregistered components are not found", func(t *testing.T) {
+		r := templ.NewComponentRegistry()
+		if _, ok := r.Lookup("missing"); ok {
+			t.Error("expected the component not to be found")
+		}
+	})
+	t.Run("MustLookup panics with the name of unregistered components", func(t *testing.T) {
+		r := templ.NewComponentRegistry()
+		defer func() {
+			err, ok := recover().(error)
+			if !ok {
+				t.Fatal("expected a panic with an error")
+			}
+			if !strings.Contains(err.Error(), `"missing"`) {
+				t.Errorf("expected the error to contain the name, got %q", err.Error())
+			}
+		}()
+		r.MustLookup("missing")
+	})
+	t.Run("the zero value can be used", func(t *testing.T) {
+		var r templ.ComponentRegistry
+		r.Register("hello", templ.Raw("Hello"))
+		if _, ok := r.Lookup("hello"); !ok {
+			t.Error("expected the component to be found")
+		}
+	})
+	t.Run("the default registry is used by the package functions", func(t *testing.T) {
+		templ.Register("templ_test.default", templ.Raw("Default"))
+		if _, ok := templ.Lookup("templ_test.default"); !ok {
+			t.Error("expected the component to be found")
+		}
+		if _, ok := templ.DefaultRegistry.Lookup("templ_test.default"); !ok {
+			t.Error("expected the component to be found in the default registry")
+		}
+	})
+	t.Run("lookups are safe for concurrent use", func(t *testing.T) {
+		r := templ.NewComponentRegistry()
+		for i := 0; i < 10; i++ {
+			r.Register(fmt.Sprintf("c%d", i), templ.NopComponent)
+		}
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				r.MustLookup(fmt.Sprintf("c%d", i))
+			}(i)
+		}
+		wg.Wait()
+	})
+}
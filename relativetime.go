@@ -0,0 +1,71 @@
+package templ
+
+import (
+	"context"
+	"io"
+	"strconv"
+	"time"
+)
+
+// relativeTimeUnits are the units used to format relative times, largest first.
+var relativeTimeUnits = []struct {
+	name    string
+	seconds int64
+}{
+	{"year", 365 * 24 * 60 * 60},
+	{"month", 30 * 24 * 60 * 60},
+	{"day", 24 * 60 * 60},
+	{"hour", 60 * 60},
+	{"minute", 60},
+	{"second", 1},
+}
+
+// NewRelativeTime renders the time relative to now, e.g. "3 hours ago", or "in 2 days",
+// which is updated in the browser using Intl.RelativeTimeFormat.
+func NewRelativeTime(t time.Time) Component {
+	return ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		if err = writeStrings(w, `<time datetime="`, t.UTC().Format(time.RFC3339), `" data-relative-time>`, formatRelativeTime(time.Until(t)), `</time>`); err != nil {
+			return err
+		}
+		return relativeTimeScript.Render(ctx, w)
+	})
+}
+
+// formatRelativeTime formats the duration in the largest whole unit, in the same way as
+// Intl.RelativeTimeFormat in English, with numeric set to always.
+func formatRelativeTime(d time.Duration) string {
+	seconds := int64(d / time.Second)
+	abs := seconds
+	if abs < 0 {
+		abs = -abs
+	}
+	for _, u := range relativeTimeUnits {
+		if abs < u.seconds && u.seconds > 1 {
+			continue
+		}
+		n := abs / u.seconds
+		s := strconv.FormatInt(n, 10) + " " + u.name
+		if n != 1 {
+			s += "s"
+		}
+		if seconds > 0 {
+			return "in " + s
+		}
+		return s + " ago"
+	}
+	return ""
+}
+
+var relativeTimeScript = ComponentScript{
+	Name: `__templ_relativeTime`,
+	Function: `(function(){` +
+		`if(!window.Intl||!Intl.RelativeTimeFormat){return;}` +
+		`var rtf=new Intl.RelativeTimeFormat(document.documentElement.lang||undefined,{numeric:"always"});` +
+		`var units=[["year",31536000],["month",2592000],["day",86400],["hour",3600],["minute",60],["second",1]];` +
+		`function update(){document.querySelectorAll("time[data-relative-time]").forEach(function(el){` +
+		`var s=Math.trunc((new Date(el.getAttribute("datetime")).getTime()-Date.now())/1000);` +
+		`for(var i=0;i<units.length;i++){if(Math.abs(s)>=units[i][1]||units[i][1]===1){el.textContent=rtf.format(Math.trunc(s/units[i][1]),units[i][0]);return;}}` +
+		`});}` +
+		`update();setInterval(update,10000);` +
+		`})();`,
+}
@@ -0,0 +1,45 @@
+package templ_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/a-h/templ"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRelativeTime(t *testing.T) {
+	tests := []struct {
+		name     string
+		offset   time.Duration
+		expected string
+	}{
+		{name: "seconds ago", offset: -30*time.Second - time.Millisecond, expected: "30 seconds ago"},
+		{name: "one minute ago", offset: -90 * time.Second, expected: "1 minute ago"},
+		{name: "hours ago", offset: -(3*time.Hour + 59*time.Minute), expected: "3 hours ago"},
+		{name: "in days", offset: 2*24*time.Hour + time.Hour, expected: "in 2 days"},
+		{name: "months ago", offset: -65 * 24 * time.Hour, expected: "2 months ago"},
+		{name: "in years", offset: 400 * 24 * time.Hour, expected: "in 1 year"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			target := time.Now().Add(tt.offset)
+			b := new(bytes.Buffer)
+			if err := templ.NewRelativeTime(target).Render(context.Background(), b); err != nil {
+				t.Fatalf("failed to render: %v", err)
+			}
+			html, script, _ := strings.Cut(b.String(), "<script")
+			expected := `<time datetime="` + target.UTC().Format(time.RFC3339) + `" data-relative-time>` + tt.expected + `</time>`
+			if diff := cmp.Diff(expected, html); diff != "" {
+				t.Error(diff)
+			}
+			if !strings.Contains(script, "Intl.RelativeTimeFormat") {
+				t.Errorf("expected the script to be rendered, got %q", script)
+			}
+		})
+	}
+}
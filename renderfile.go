@@ -0,0 +1,46 @@
+package templ
+
+import (
+	"bufio"
+	"context"
+	"os"
+	"path/filepath"
+)
+
+// RenderToFile renders the component to the file at path, creating any missing parent
+// directories. It's intended for static site generation.
+//
+// The component is rendered to a temporary file in the same directory, which is renamed
+// to path once rendering is complete, so a partially written file is never served. If
+// rendering fails, the temporary file is removed, and any existing file at path is left
+// unchanged.
+func RenderToFile(ctx context.Context, c Component, path string, perm os.FileMode) (err error) {
+	dir := filepath.Dir(path)
+	if err = os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(dir, "."+filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = f.Close()
+			_ = os.Remove(f.Name())
+		}
+	}()
+	if err = f.Chmod(perm); err != nil {
+		return err
+	}
+	bw := bufio.NewWriter(f)
+	if err = c.Render(ctx, bw); err != nil {
+		return err
+	}
+	if err = bw.Flush(); err != nil {
+		return err
+	}
+	if err = f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
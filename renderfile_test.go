@@ -0,0 +1,64 @@
+package templ_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRenderToFile(t *testing.T) {
+	t.Run("the output is written to the file, creating parent directories", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "blog", "post", "index.html")
+		if err := templ.RenderToFile(context.Background(), templ.Raw("<h1>Post</h1>"), path, 0o640); err != nil {
+			t.Fatalf("failed to render: %v", err)
+		}
+		actual, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatalf("failed to read file: %v", err)
+		}
+		if diff := cmp.Diff("<h1>Post</h1>", string(actual)); diff != "" {
+			t.Error(diff)
+		}
+		if runtime.GOOS != "windows" {
+			info, err := os.Stat(path)
+			if err != nil {
+				t.Fatalf("failed to stat file: %v", err)
+			}
+			if info.Mode().Perm() != 0o640 {
+				t.Errorf("expected permissions %v, got %v", os.FileMode(0o640), info.Mode().Perm())
+			}
+		}
+	})
+	t.Run("failed renders leave existing files unchanged", func(t *testing.T) {
+		dir := t.TempDir()
+		path := filepath.Join(dir, "index.html")
+		if err := os.WriteFile(path, []byte("<h1>Existing</h1>"), 0o644); err != nil {
+			t.Fatalf("failed to write file: %v", err)
+		}
+		expectedErr := errors.New("render error")
+		err := templ.RenderToFile(context.Background(), templ.Raw("<h1>Partial", expectedErr), path, 0o644)
+		if !errors.Is(err, expectedErr) {
+			t.Errorf("expected %v, got %v", expectedErr, err)
+		}
+		actual, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatalf("failed to read file: %v", err)
+		}
+		if diff := cmp.Diff("<h1>Existing</h1>", string(actual)); diff != "" {
+			t.Error(diff)
+		}
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			t.Fatalf("failed to read directory: %v", err)
+		}
+		if len(entries) != 1 {
+			t.Errorf("expected the temporary file to be removed, got %d files", len(entries))
+		}
+	})
+}
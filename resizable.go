@@ -0,0 +1,92 @@
+package templ
+
+import (
+	"context"
+	"io"
+)
+
+// ResizableOrientation is the orientation of the separator between the panels of a
+// resizable layout.
+type ResizableOrientation string
+
+const (
+	// ResizableVertical separates the panels with a vertical separator, so the panels
+	// are side by side.
+	ResizableVertical ResizableOrientation = "vertical"
+	// ResizableHorizontal separates the panels with a horizontal separator, so the
+	// panels are stacked.
+	ResizableHorizontal ResizableOrientation = "horizontal"
+)
+
+var resizableClass = newComponentCSSRules("resizable",
+	`&{display:flex;width:100%;height:100%;}`+
+		`&[data-orientation=horizontal]{flex-direction:column;}`+
+		`&>.resizable-panel{overflow:auto;min-width:0;min-height:0;}`+
+		`&>.resizable-panel:last-child{flex:1 1 0;}`+
+		`&>[role=separator]{flex:0 0 0.5em;background-color:#e5e7eb;cursor:col-resize;touch-action:none;}`+
+		`&[data-orientation=horizontal]>[role=separator]{cursor:row-resize;}`+
+		`&>[role=separator]:focus-visible{outline:2px solid #2563eb;}`)
+
+// NewResizable renders two panels, separated by a handle that resizes the panels when
+// dragged, or when the arrow keys are pressed while it has focus.
+//
+// The initialSplit is the percentage of the space used by the first panel, from 0 to 100.
+func NewResizable(left, right Component, initialSplit float64, orientation ResizableOrientation) Component {
+	if initialSplit < 0 {
+		initialSplit = 0
+	}
+	if initialSplit > 100 {
+		initialSplit = 100
+	}
+	if orientation != ResizableHorizontal {
+		orientation = ResizableVertical
+	}
+	return ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		if err = RenderCSSItems(ctx, w, resizableClass); err != nil {
+			return err
+		}
+		pct := formatFloat(initialSplit)
+		if err = writeStrings(w,
+			`<div class="resizable `, resizableClass.ID, `" data-orientation="`, string(orientation), `">`,
+			`<div class="resizable-panel" style="flex:0 0 `, pct, `%;">`); err != nil {
+			return err
+		}
+		if err = left.Render(ctx, w); err != nil {
+			return err
+		}
+		if err = writeStrings(w,
+			`</div>`,
+			`<div role="separator" tabindex="0" aria-label="Resize panels" aria-orientation="`, string(orientation),
+			`" aria-valuemin="0" aria-valuemax="100" aria-valuenow="`, pct, `" data-resizable-handle></div>`,
+			`<div class="resizable-panel">`); err != nil {
+			return err
+		}
+		if err = right.Render(ctx, w); err != nil {
+			return err
+		}
+		if _, err = io.WriteString(w, `</div></div>`); err != nil {
+			return err
+		}
+		return resizableScript.Render(ctx, w)
+	})
+}
+
+var resizableScript = ComponentScript{
+	Name: `__templ_resizable`,
+	Function: `(function(){` +
+		`function resize(handle,pct){pct=Math.max(0,Math.min(100,Math.round(pct*10)/10));` +
+		`handle.previousElementSibling.style.flex="0 0 "+pct+"%";handle.setAttribute("aria-valuenow",String(pct));}` +
+		`function handle(e){return e.target.closest&&e.target.closest("[data-resizable-handle]");}` +
+		`document.addEventListener("pointerdown",function(e){var h=handle(e);if(!h){return;}e.preventDefault();h.setPointerCapture(e.pointerId);` +
+		`var container=h.parentElement;var vertical=h.getAttribute("aria-orientation")==="vertical";` +
+		`function move(e){var rect=container.getBoundingClientRect();` +
+		`resize(h,vertical?(e.clientX-rect.left)/rect.width*100:(e.clientY-rect.top)/rect.height*100);}` +
+		`function up(){h.removeEventListener("pointermove",move);h.removeEventListener("pointerup",up);}` +
+		`h.addEventListener("pointermove",move);h.addEventListener("pointerup",up);});` +
+		`document.addEventListener("keydown",function(e){var h=handle(e);if(!h){return;}var pct=parseFloat(h.getAttribute("aria-valuenow"));` +
+		`var step=e.shiftKey?10:1;` +
+		`if(e.key==="ArrowLeft"||e.key==="ArrowUp"){pct-=step;}else if(e.key==="ArrowRight"||e.key==="ArrowDown"){pct+=step;}` +
+		`else if(e.key==="Home"){pct=0;}else if(e.key==="End"){pct=100;}else{return;}` +
+		`e.preventDefault();resize(h,pct);});` +
+		`})();`,
+}
@@ -0,0 +1,57 @@
+package templ_test
+
+import (
+	"bytes"
+	"context"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/google/go-cmp/cmp"
+)
+
+var resizableClassPattern = regexp.MustCompile(`resizable_[0-9a-f]{4}`)
+
+func TestResizable(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    templ.Component
+		expected string
+	}{
+		{
+			name:  "vertical separator",
+			input: templ.NewResizable(templ.Raw("Left"), templ.Raw("Right"), 30, templ.ResizableVertical),
+			expected: `<div class="resizable resizable" data-orientation="vertical">` +
+				`<div class="resizable-panel" style="flex:0 0 30%;">Left</div>` +
+				`<div role="separator" tabindex="0" aria-label="Resize panels" aria-orientation="vertical" aria-valuemin="0" aria-valuemax="100" aria-valuenow="30" data-resizable-handle></div>` +
+				`<div class="resizable-panel">Right</div></div>`,
+		},
+		{
+			name:  "horizontal separator, with the split limited to 100%",
+			input: templ.NewResizable(templ.Raw("Top"), templ.Raw("Bottom"), 120, templ.ResizableHorizontal),
+			expected: `<div class="resizable resizable" data-orientation="horizontal">` +
+				`<div class="resizable-panel" style="flex:0 0 100%;">Top</div>` +
+				`<div role="separator" tabindex="0" aria-label="Resize panels" aria-orientation="horizontal" aria-valuemin="0" aria-valuemax="100" aria-valuenow="100" data-resizable-handle></div>` +
+				`<div class="resizable-panel">Bottom</div></div>`,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			b := new(bytes.Buffer)
+			if err := tt.input.Render(context.Background(), b); err != nil {
+				t.Fatalf("failed to render: %v", err)
+			}
+			output := resizableClassPattern.ReplaceAllString(b.String(), "resizable")
+			style, rest, ok := strings.Cut(output, "</style>")
+			if !ok || !strings.Contains(style, ".resizable>[role=separator]") {
+				t.Errorf("expected the CSS to be rendered, got %q", style)
+			}
+			html, _, _ := strings.Cut(rest, "<script")
+			if diff := cmp.Diff(tt.expected, html); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
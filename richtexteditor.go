@@ -0,0 +1,157 @@
+package templ
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// RTEOption configures a rich text editor.
+type RTEOption func(*rteConfig)
+
+type rteConfig struct {
+	toolbar   []string
+	sanitizer func(html string) string
+}
+
+// WithToolbar adds a toolbar button for each command, e.g. "bold", "italic", or
+// "insertUnorderedList".
+func WithToolbar(commands []string) RTEOption {
+	return func(c *rteConfig) {
+		c.toolbar = commands
+	}
+}
+
+// WithHTMLSanitizer sets the function used to sanitize the initial content of the editor.
+//
+// The default sanitizer only allows basic formatting elements, and removes all attributes
+// except the href of links, which is sanitized with URL.
+func WithHTMLSanitizer(sanitizer func(html string) string) RTEOption {
+	return func(c *rteConfig) {
+		c.sanitizer = sanitizer
+	}
+}
+
+// NewRichTextEditor renders a contenteditable element containing the sanitized content,
+// and a hidden textarea with the given name that's kept in sync with the editor for form
+// submission.
+//
+// A templ:rich-text-editor event is dispatched on the contenteditable element when it's
+// ready, so that an editor library, e.g. ProseMirror or Quill, can be initialized.
+func NewRichTextEditor(name string, content string, opts ...RTEOption) Component {
+	config := rteConfig{
+		sanitizer: sanitizeRichText,
+	}
+	for _, o := range opts {
+		o(&config)
+	}
+	return ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		id := EscapeString(name)
+		editorID := id + "-editor"
+		sanitized := config.sanitizer(content)
+		if _, err = io.WriteString(w, `<div class="rich-text-editor" data-rich-text-editor>`); err != nil {
+			return err
+		}
+		if len(config.toolbar) > 0 {
+			if err = writeStrings(w, `<div role="toolbar" aria-label="Formatting" aria-controls="`, editorID, `">`); err != nil {
+				return err
+			}
+			for _, command := range config.toolbar {
+				c := EscapeString(command)
+				if err = writeStrings(w, `<button type="button" data-command="`, c, `">`, c, `</button>`); err != nil {
+					return err
+				}
+			}
+			if _, err = io.WriteString(w, `</div>`); err != nil {
+				return err
+			}
+		}
+		if err = writeStrings(w,
+			`<div id="`, editorID, `" contenteditable="true" role="textbox" aria-multiline="true">`, sanitized, `</div>`,
+			`<textarea id="`, id, `" name="`, id, `" hidden>`, EscapeString(sanitized), `</textarea>`,
+			`</div>`); err != nil {
+			return err
+		}
+		return richTextEditorScript.Render(ctx, w)
+	})
+}
+
+var richTextElements = map[string]struct{}{
+	"a": {}, "b": {}, "blockquote": {}, "br": {}, "code": {}, "em": {}, "h1": {}, "h2": {}, "h3": {},
+	"h4": {}, "h5": {}, "h6": {}, "i": {}, "li": {}, "ol": {}, "p": {}, "pre": {}, "s": {}, "strong": {},
+	"u": {}, "ul": {},
+}
+
+// richTextRemovedElements are elements that are removed, along with their content.
+var richTextRemovedElements = map[string]struct{}{
+	"script": {}, "style": {}, "iframe": {}, "object": {}, "embed": {}, "template": {}, "noscript": {},
+}
+
+// sanitizeRichText removes elements that are not basic formatting, and all attributes
+// except the href of links.
+func sanitizeRichText(s string) string {
+	var sb strings.Builder
+	var removing string
+	z := html.NewTokenizer(strings.NewReader(s))
+	for {
+		tt := z.Next()
+		if tt == html.ErrorToken {
+			if err := z.Err(); !errors.Is(err, io.EOF) {
+				return ""
+			}
+			return sb.String()
+		}
+		t := z.Token()
+		if removing != "" {
+			if tt == html.EndTagToken && t.Data == removing {
+				removing = ""
+			}
+			continue
+		}
+		switch tt {
+		case html.TextToken:
+			sb.WriteString(EscapeString(t.Data))
+		case html.StartTagToken, html.SelfClosingTagToken:
+			if _, ok := richTextRemovedElements[t.Data]; ok {
+				if tt == html.StartTagToken {
+					removing = t.Data
+				}
+				continue
+			}
+			if _, ok := richTextElements[t.Data]; !ok {
+				continue
+			}
+			sb.WriteString("<" + t.Data)
+			if t.Data == "a" {
+				for _, a := range t.Attr {
+					if a.Key == "href" {
+						sb.WriteString(` href="` + EscapeString(string(URL(a.Val))) + `"`)
+					}
+				}
+			}
+			sb.WriteString(">")
+		case html.EndTagToken:
+			if _, ok := richTextElements[t.Data]; ok && t.Data != "br" {
+				sb.WriteString("</" + t.Data + ">")
+			}
+		}
+	}
+}
+
+var richTextEditorScript = ComponentScript{
+	Name: `__templ_richTextEditor`,
+	Function: `(function(){` +
+		`function sync(editor){var root=editor.closest("[data-rich-text-editor]");root.querySelector("textarea").value=editor.innerHTML;}` +
+		`function ready(){document.querySelectorAll("[data-rich-text-editor] [contenteditable]:not([data-rich-text-editor-ready])").forEach(function(editor){` +
+		`editor.setAttribute("data-rich-text-editor-ready","");editor.dispatchEvent(new CustomEvent("templ:rich-text-editor",{bubbles:true}));});}` +
+		// Editors rendered after the script are ready once the document has loaded.
+		`ready();if(document.readyState==="loading"){document.addEventListener("DOMContentLoaded",ready);}` +
+		`document.addEventListener("input",function(e){var editor=e.target;if(editor.closest&&editor.closest("[data-rich-text-editor] [contenteditable]")){sync(editor.closest("[contenteditable]"));}});` +
+		`document.addEventListener("click",function(e){var button=e.target.closest&&e.target.closest("[data-rich-text-editor] [data-command]");if(!button){return;}` +
+		`var editor=document.getElementById(button.parentElement.getAttribute("aria-controls"));editor.focus();` +
+		`document.execCommand(button.getAttribute("data-command"),false,null);sync(editor);});` +
+		`})();`,
+}
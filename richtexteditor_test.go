@@ -0,0 +1,75 @@
+package templ_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRichTextEditor(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    templ.Component
+		expected string
+	}{
+		{
+			name:  "formatting is kept",
+			input: templ.NewRichTextEditor("body", `<p>Hello <strong>world</strong><br></p>`),
+			expected: `<div class="rich-text-editor" data-rich-text-editor>` +
+				`<div id="body-editor" contenteditable="true" role="textbox" aria-multiline="true"><p>Hello <strong>world</strong><br></p></div>` +
+				`<textarea id="body" name="body" hidden>&lt;p&gt;Hello &lt;strong&gt;world&lt;/strong&gt;&lt;br&gt;&lt;/p&gt;</textarea></div>`,
+		},
+		{
+			name:  "scripts, unknown elements and attributes are removed",
+			input: templ.NewRichTextEditor("body", `<p onclick="alert(1)">A<script>alert(2)</script><span class="x">B</span><a href="javascript:alert(3)" target="_blank">C</a><a href="/d">D</a></p>`),
+			expected: `<div class="rich-text-editor" data-rich-text-editor>` +
+				`<div id="body-editor" contenteditable="true" role="textbox" aria-multiline="true"><p>AB<a href="about:invalid#TemplFailedSanitizationURL">C</a><a href="/d">D</a></p></div>` +
+				`<textarea id="body" name="body" hidden>&lt;p&gt;AB&lt;a href=&#34;about:invalid#TemplFailedSanitizationURL&#34;&gt;C&lt;/a&gt;&lt;a href=&#34;/d&#34;&gt;D&lt;/a&gt;&lt;/p&gt;</textarea></div>`,
+		},
+		{
+			name:  "text is escaped",
+			input: templ.NewRichTextEditor("body", `1 &lt; 2`),
+			expected: `<div class="rich-text-editor" data-rich-text-editor>` +
+				`<div id="body-editor" contenteditable="true" role="textbox" aria-multiline="true">1 &lt; 2</div>` +
+				`<textarea id="body" name="body" hidden>1 &amp;lt; 2</textarea></div>`,
+		},
+		{
+			name:  "a custom sanitizer can be used",
+			input: templ.NewRichTextEditor("body", `<p>Text</p>`, templ.WithHTMLSanitizer(strings.ToUpper)),
+			expected: `<div class="rich-text-editor" data-rich-text-editor>` +
+				`<div id="body-editor" contenteditable="true" role="textbox" aria-multiline="true"><P>TEXT</P></div>` +
+				`<textarea id="body" name="body" hidden>&lt;P&gt;TEXT&lt;/P&gt;</textarea></div>`,
+		},
+		{
+			name:  "toolbar buttons are rendered for each command",
+			input: templ.NewRichTextEditor("body", "", templ.WithToolbar([]string{"bold", "italic"})),
+			expected: `<div class="rich-text-editor" data-rich-text-editor>` +
+				`<div role="toolbar" aria-label="Formatting" aria-controls="body-editor"><button type="button" data-command="bold">bold</button><button type="button" data-command="italic">italic</button></div>` +
+				`<div id="body-editor" contenteditable="true" role="textbox" aria-multiline="true"></div>` +
+				`<textarea id="body" name="body" hidden></textarea></div>`,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			b := new(bytes.Buffer)
+			if err := tt.input.Render(context.Background(), b); err != nil {
+				t.Fatalf("failed to render: %v", err)
+			}
+			html, script, ok := strings.Cut(b.String(), "<script")
+			if !ok {
+				t.Error("expected the rich text editor script to be rendered")
+			}
+			if !strings.Contains(script, `document.addEventListener("DOMContentLoaded",ready)`) {
+				t.Errorf("expected editors rendered after the script to be made ready on DOMContentLoaded, got %q", script)
+			}
+			if diff := cmp.Diff(tt.expected, html); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
@@ -11,14 +11,17 @@ import (
 	"html"
 	"html/template"
 	"io"
+	"log/slog"
 	"net/http"
 	"os"
 	"reflect"
+	"regexp"
 	"runtime"
 	"sort"
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/a-h/templ/safehtml"
@@ -36,17 +39,54 @@ type Component interface {
 // Render method into a Component.
 type ComponentFunc func(ctx context.Context, w io.Writer) error
 
+// renderHooksEnabled is set once development mode or metrics have been enabled, so that
+// components aren't slowed down by looking them up in the context until they're used.
+var renderHooksEnabled atomic.Bool
+
 // Render the template.
 func (cf ComponentFunc) Render(ctx context.Context, w io.Writer) error {
+	if !renderHooksEnabled.Load() {
+		return cf(ctx, w)
+	}
+	if v, ok := ctx.Value(devModeContextKey).(devModeContextValue); ok && v.enabled && !v.validating {
+		return renderWithDevMode(ctx, w, cf)
+	}
+	if v, ok := ctx.Value(metricsContextKey).(metricsContextValue); ok {
+		return renderWithMetrics(ctx, w, v, cf)
+	}
 	return cf(ctx, w)
 }
 
-func WithChildren(ctx context.Context, children Component) context.Context {
+// WithChildren sets the children rendered by the { children... } expression.
+// Multiple children are rendered in sequence. If no children are passed, the
+// NopComponent is set.
+func WithChildren(ctx context.Context, children ...Component) context.Context {
+	var c Component
+	switch len(children) {
+	case 0:
+		c = NopComponent
+	case 1:
+		c = children[0]
+	default:
+		c = fragment(children)
+	}
 	ctx, v := getContext(ctx)
-	v.children = &children
+	v.children = &c
 	return ctx
 }
 
+// fragment renders a list of components in sequence.
+type fragment []Component
+
+func (f fragment) Render(ctx context.Context, w io.Writer) (err error) {
+	for _, c := range f {
+		if err = c.Render(ctx, w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func ClearChildren(ctx context.Context) context.Context {
 	_, v := getContext(ctx)
 	v.children = nil
@@ -65,18 +105,54 @@ func GetChildren(ctx context.Context) Component {
 	return *v.children
 }
 
+// HasChildren returns true if children have been set in the context, and the children are
+// not the NopComponent. The children are not rendered.
+//
+// Generated templates clear the children from the context when they start rendering, so
+// HasChildren is intended for use in Go code that renders templates.
+func HasChildren(ctx context.Context) bool {
+	v, ok := ctx.Value(contextKey).(*contextValue)
+	if !ok || v.children == nil || *v.children == nil {
+		return false
+	}
+	return !isNopComponent(*v.children)
+}
+
+var nopComponentPointer = reflect.ValueOf(NopComponent).Pointer()
+
+// isNopComponent returns true if c is the NopComponent. Functions can't be compared
+// directly, so the function pointers are compared.
+func isNopComponent(c Component) bool {
+	cf, ok := c.(ComponentFunc)
+	return ok && reflect.ValueOf(cf).Pointer() == nopComponentPointer
+}
+
 // ComponentHandler is a http.Handler that renders components.
 type ComponentHandler struct {
 	Component    Component
 	Status       int
 	ContentType  string
 	ErrorHandler func(r *http.Request, err error) http.Handler
+	// Logger, if set, receives a structured log entry for each render.
+	Logger *slog.Logger
 }
 
 const componentHandlerErrorMessage = "templ: failed to render template"
 
 // ServeHTTP implements the http.Handler interface.
 func (ch ComponentHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if ch.Logger == nil {
+		_ = ch.serve(w, r)
+		return
+	}
+	start := time.Now()
+	sw := &statusWriter{ResponseWriter: w, status: http.StatusOK}
+	err := ch.serve(sw, r)
+	ch.log(r, sw.status, time.Since(start), err)
+}
+
+// serve renders the component to w, and returns the render error, if any.
+func (ch ComponentHandler) serve(w http.ResponseWriter, r *http.Request) error {
 	// Since the component may error, write to a buffer first.
 	// This prevents partial responses from being written to the client.
 	buf := GetBuffer()
@@ -86,10 +162,10 @@ func (ch ComponentHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		if ch.ErrorHandler != nil {
 			w.Header().Set("Content-Type", ch.ContentType)
 			ch.ErrorHandler(r, err).ServeHTTP(w, r)
-			return
+			return err
 		}
 		http.Error(w, componentHandlerErrorMessage, http.StatusInternalServerError)
-		return
+		return err
 	}
 	w.Header().Set("Content-Type", ch.ContentType)
 	if ch.Status != 0 {
@@ -98,6 +174,51 @@ func (ch ComponentHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	// Ignore write error like http.Error() does, because there is
 	// no way to recover at this point.
 	_, _ = w.Write(buf.Bytes())
+	return nil
+}
+
+func (ch ComponentHandler) log(r *http.Request, status int, d time.Duration, err error) {
+	attrs := []slog.Attr{
+		slog.String("method", r.Method),
+		slog.String("path", r.URL.Path),
+		slog.Int("status", status),
+		slog.Int64("duration_ms", d.Milliseconds()),
+	}
+	if err != nil {
+		attrs = append(attrs, slog.Any("error", err))
+		ch.Logger.LogAttrs(r.Context(), slog.LevelError, "templ: failed to render component", attrs...)
+		return
+	}
+	ch.Logger.LogAttrs(r.Context(), slog.LevelInfo, "templ: rendered component", attrs...)
+}
+
+// statusWriter records the status code written to the underlying http.ResponseWriter.
+type statusWriter struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+}
+
+func (sw *statusWriter) WriteHeader(status int) {
+	if !sw.wroteHeader {
+		sw.status = status
+		sw.wroteHeader = true
+	}
+	sw.ResponseWriter.WriteHeader(status)
+}
+
+// Flush implements http.Flusher, so that wrapping the http.ResponseWriter doesn't prevent
+// error handlers from streaming responses.
+func (sw *statusWriter) Flush() {
+	if f, ok := sw.ResponseWriter.(http.Flusher); ok {
+		sw.wroteHeader = true
+		f.Flush()
+	}
+}
+
+// Unwrap returns the underlying http.ResponseWriter, for use by http.ResponseController.
+func (sw *statusWriter) Unwrap() http.ResponseWriter {
+	return sw.ResponseWriter
 }
 
 // Handler creates a http.Handler that renders the template.
@@ -133,6 +254,14 @@ func WithErrorHandler(eh func(r *http.Request, err error) http.Handler) func(*Co
 	}
 }
 
+// WithLogger sets the logger used to record each render of the ComponentHandler.
+// Successful renders are logged at INFO level, failures at ERROR level.
+func WithLogger(l *slog.Logger) func(*ComponentHandler) {
+	return func(ch *ComponentHandler) {
+		ch.Logger = l
+	}
+}
+
 // EscapeString escapes HTML text within templates.
 func EscapeString(s string) string {
 	return html.EscapeString(s)
@@ -164,6 +293,117 @@ func (classes CSSClasses) String() string {
 	return cp.String()
 }
 
+// Merge returns the classes, followed by the classes in other that are not already present.
+// Classes are compared using their class name. Nested CSSClasses are flattened. The receiver
+// is not modified.
+func (classes CSSClasses) Merge(other CSSClasses) CSSClasses {
+	merged := appendFlattenedCSSClasses(make(CSSClasses, 0, len(classes)+len(other)), classes)
+	names := make(map[string]struct{}, len(merged))
+	for _, c := range merged {
+		if name, ok := cssClassName(c); ok {
+			names[name] = struct{}{}
+		}
+	}
+	for _, c := range appendFlattenedCSSClasses(nil, other) {
+		if name, ok := cssClassName(c); ok {
+			if _, exists := names[name]; exists {
+				continue
+			}
+			names[name] = struct{}{}
+		}
+		merged = append(merged, c)
+	}
+	return merged
+}
+
+// MergeClasses returns the classes in a, followed by the classes in b that are not in a.
+func MergeClasses(a, b CSSClasses) CSSClasses {
+	return a.Merge(b)
+}
+
+// Has returns true if the classes contain a class with the name. Classes are compared
+// using their class name. Conditional classes, e.g. map[string]bool, are not checked.
+func (classes CSSClasses) Has(name string) bool {
+	for _, c := range classes {
+		if nested, ok := c.(CSSClasses); ok {
+			if nested.Has(name) {
+				return true
+			}
+			continue
+		}
+		if n, ok := cssClassName(c); ok && n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Filter returns a new CSSClasses containing the classes where keep returns true. String
+// class names are passed to keep as a ConstantCSSClass. Conditional classes, e.g.
+// map[string]bool, are always kept. The receiver is not modified.
+func (classes CSSClasses) Filter(keep func(CSSClass) bool) CSSClasses {
+	filtered := make(CSSClasses, 0, len(classes))
+	for _, c := range classes {
+		switch c := c.(type) {
+		case string:
+			if !keep(ConstantCSSClass(c)) {
+				continue
+			}
+		case CSSClass:
+			if !keep(c) {
+				continue
+			}
+		case CSSClasses:
+			filtered = append(filtered, c.Filter(keep))
+			continue
+		}
+		filtered = append(filtered, c)
+	}
+	return filtered
+}
+
+// Unique returns a new CSSClasses that only contains the first occurrence of each class.
+// Classes are compared using their class name. Conditional classes, e.g. map[string]bool,
+// are always kept. Nested CSSClasses are flattened. The receiver is not modified.
+func (classes CSSClasses) Unique() CSSClasses {
+	unique := make(CSSClasses, 0, len(classes))
+	names := make(map[string]struct{}, len(classes))
+	for _, c := range appendFlattenedCSSClasses(nil, classes) {
+		if name, ok := cssClassName(c); ok {
+			if _, exists := names[name]; exists {
+				continue
+			}
+			names[name] = struct{}{}
+		}
+		unique = append(unique, c)
+	}
+	return unique
+}
+
+// appendFlattenedCSSClasses appends the items in classes to dst, replacing nested CSSClasses
+// with their items.
+func appendFlattenedCSSClasses(dst CSSClasses, classes CSSClasses) CSSClasses {
+	for _, c := range classes {
+		if nested, ok := c.(CSSClasses); ok {
+			dst = appendFlattenedCSSClasses(dst, nested)
+			continue
+		}
+		dst = append(dst, c)
+	}
+	return dst
+}
+
+// cssClassName returns the name of a class, if the item is a single class.
+func cssClassName(item any) (name string, ok bool) {
+	switch c := item.(type) {
+	case string:
+		return c, true
+	case CSSClass:
+		return c.ClassName(), true
+	}
+	return "", false
+}
+
 func newCSSProcessor() *cssProcessor {
 	return &cssProcessor{
 		classNameToEnabled: make(map[string]bool),
@@ -233,7 +473,7 @@ func (cp *cssProcessor) String() string {
 	rendered := make(map[string]any, len(cp.classNameToEnabled))
 	var names []string
 	for _, name := range cp.orderedNames {
-		if enabled := cp.classNameToEnabled[name]; !enabled {
+		if enabled := cp.classNameToEnabled[name]; !enabled || name == "" {
 			continue
 		}
 		if _, hasBeenRendered := rendered[name]; hasBeenRendered {
@@ -268,12 +508,32 @@ func Class(name string) CSSClass {
 	return SafeClass(name)
 }
 
+var safeClassName = regexp.MustCompile(`^-?[_a-zA-Z]+[_a-zA-Z0-9-]*$`)
+
+// ValidCSSClassName returns true if the name is a valid CSS class name, i.e. it starts with
+// an optional hyphen, followed by a letter or underscore, then letters, digits, underscores
+// or hyphens. It can be used to validate class names in tools and code generators.
+func ValidCSSClassName(name string) bool {
+	return safeClassName.MatchString(name)
+}
+
 // SafeClass bypasses CSS class name validation.
 // Deprecated: use a string instead.
 func SafeClass(name string) CSSClass {
 	return ConstantCSSClass(name)
 }
 
+// NopClass is a CSS class with an empty class name, which is not rendered.
+var NopClass CSSClass = ConstantCSSClass("")
+
+// MaybeClass returns the class if cond is true, otherwise the NopClass.
+func MaybeClass(cond bool, name string) CSSClass {
+	if !cond {
+		return NopClass
+	}
+	return ConstantCSSClass(name)
+}
+
 // CSSClass provides a class name.
 type CSSClass interface {
 	ClassName() string
@@ -301,16 +561,90 @@ func (css ComponentCSSClass) ClassName() string {
 	return css.ID
 }
 
+// renderKey is used to track whether the CSS has been rendered. CSS that is wrapped in an
+// at-rule, e.g. a media query, is tracked separately to CSS with the same ID that isn't.
+func (css ComponentCSSClass) renderKey() string {
+	if !strings.HasPrefix(string(css.Class), "@") {
+		return css.ID
+	}
+	prelude, _, _ := strings.Cut(string(css.Class), "{")
+	return css.ID + " " + prelude
+}
+
+// DefaultCSSIDLength is the number of hex characters of the hash used in the IDs of CSS
+// classes in generated code. Increase it at startup to reduce the chance of collisions in
+// applications with a large number of CSS classes.
+var DefaultCSSIDLength = 4
+
 // CSSID calculates an ID.
 func CSSID(name string, css string) string {
+	return CSSIDWithLength(name, css, 4)
+}
+
+// CSSIDWithLength calculates an ID, using length hex characters of the SHA-256 hash of the
+// CSS. The length is clamped to between 1 and 64.
+func CSSIDWithLength(name string, css string, length int) string {
+	if length < 1 {
+		length = 1
+	}
+	if length > sha256.Size*2 {
+		length = sha256.Size * 2
+	}
 	sum := sha256.Sum256([]byte(css))
-	hp := hex.EncodeToString(sum[:])[0:4]
+	hp := hex.EncodeToString(sum[:])[0:length]
 	// Benchmarking showed this was fastest, and with fewest allocations (1).
 	// Using strings.Builder (2 allocs).
 	// Using fmt.Sprintf (3 allocs).
 	return name + "_" + hp
 }
 
+// newComponentCSSClass creates a ComponentCSSClass from CSS properties, in the same way as
+// generated code. It's used by the components included in the templ package.
+func newComponentCSSClass(name string, css SafeCSS) ComponentCSSClass {
+	id := CSSIDWithLength(name, string(css), DefaultCSSIDLength)
+	return ComponentCSSClass{
+		ID:    id,
+		Class: SafeCSS("." + id + "{" + string(css) + "}"),
+	}
+}
+
+// newComponentCSSRules creates a ComponentCSSClass from CSS rules, where each & is replaced
+// by the class selector. It's used by components that style their descendants.
+func newComponentCSSRules(name string, rules string) ComponentCSSClass {
+	id := CSSIDWithLength(name, rules, DefaultCSSIDLength)
+	return ComponentCSSClass{
+		ID:    id,
+		Class: SafeCSS(strings.ReplaceAll(rules, "&", "."+id)),
+	}
+}
+
+// NewMediaCSSClass creates a ComponentCSSClass that only applies when the media query
+// matches, e.g. "(prefers-color-scheme: dark)". The id is used as the class name, so the
+// same id can be used to add media specific CSS to an existing class.
+//
+// The media query may only contain the all, screen and print media types, and known media
+// features. Other media queries are replaced with one that never matches.
+func NewMediaCSSClass(id, mediaQuery string, css SafeCSS) ComponentCSSClass {
+	mediaQuery = safehtml.SanitizeMediaQuery(mediaQuery)
+	return ComponentCSSClass{
+		ID:    id,
+		Class: SafeCSS("@media " + mediaQuery + "{." + id + "{" + string(css) + "}}"),
+	}
+}
+
+// NewSupportsCSSClass creates a ComponentCSSClass that only applies when the browser
+// supports the condition, e.g. "display: grid". The id is used as the class name, so the
+// same id can be used to progressively enhance an existing class.
+//
+// Each declaration in the condition is sanitized in the same way as CSS property values.
+// Unsafe conditions are replaced with one that never matches.
+func NewSupportsCSSClass(id, supportsQuery string, css SafeCSS) ComponentCSSClass {
+	return ComponentCSSClass{
+		ID:    id,
+		Class: SafeCSS("@supports " + safehtml.SanitizeSupportsCondition(supportsQuery) + "{." + id + "{" + string(css) + "}}"),
+	}
+}
+
 // NewCSSMiddleware creates HTTP middleware that renders a global stylesheet of ComponentCSSClass
 // CSS if the request path matches, or updates the HTTP context to ensure that any handlers that
 // use templ.Components skip rendering <style> elements for classes that are included in the global
@@ -338,7 +672,7 @@ func (cssm CSSMiddleware) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	// Add registered classes to the context.
 	ctx, v := getContext(r.Context())
 	for _, c := range cssm.CSSHandler.Classes {
-		v.addClass(c.ID)
+		v.addClass(c.renderKey())
 	}
 	// Serve the request. Templ components will use the updated context
 	// to know to skip rendering <style> elements for any component CSS
@@ -405,9 +739,9 @@ func renderCSSItemsToBuilder(sb *strings.Builder, v *contextValue, classes ...an
 	for _, c := range classes {
 		switch ccc := c.(type) {
 		case ComponentCSSClass:
-			if !v.hasClassBeenRendered(ccc.ID) {
+			if !v.hasClassBeenRendered(ccc.renderKey()) {
 				sb.WriteString(string(ccc.Class))
-				v.addClass(ccc.ID)
+				v.addClass(ccc.renderKey())
 			}
 		case KeyValue[ComponentCSSClass, bool]:
 			if !ccc.Value {
@@ -453,14 +787,38 @@ type SafeCSSProperty string
 var safeCSSPropertyType = reflect.TypeOf(SafeCSSProperty(""))
 
 // SanitizeCSS sanitizes CSS properties to ensure that they are safe.
+// The url() values of properties that take URLs, e.g. background-image, must also pass
+// the checks made by SanitizeCSSURL, but are otherwise output unchanged.
 func SanitizeCSS[T ~string](property string, value T) SafeCSS {
 	if reflect.TypeOf(value) == safeCSSPropertyType {
 		return SafeCSS(safehtml.SanitizeCSSProperty(property) + ":" + string(value) + ";")
 	}
 	p, v := safehtml.SanitizeCSS(property, string(value))
+	if !cssURLsAreSafe(v) {
+		v = safehtml.InnocuousPropertyValue
+	}
 	return SafeCSS(p + ":" + v + ";")
 }
 
+// cssURLsAreSafe returns false if any of the comma separated url() values in the sanitized
+// value fails sanitizeCSSURL. Only properties that take URLs have url() values that pass
+// safehtml.SanitizeCSS, so other properties are unaffected.
+func cssURLsAreSafe(v string) bool {
+	if !strings.Contains(v, "url(") {
+		return true
+	}
+	for _, u := range strings.Split(v, ",") {
+		u = strings.TrimSpace(u)
+		if !strings.HasPrefix(u, "url(") {
+			continue
+		}
+		if _, ok := sanitizeCSSURL(u); !ok {
+			return false
+		}
+	}
+	return true
+}
+
 // Attributes is an alias to map[string]any made for spread attributes.
 type Attributes map[string]any
 
@@ -499,6 +857,10 @@ func RenderAttributes(ctx context.Context, w io.Writer, attributes Attributes) (
 					return err
 				}
 			}
+		case SafeAttr:
+			if err = writeStrings(w, ` `, EscapeString(key), `="`, string(value), `"`); err != nil {
+				return err
+			}
 		case bool:
 			if value {
 				if err = writeStrings(w, ` `, EscapeString(key)); err != nil {
@@ -550,6 +912,10 @@ func safeEncodeScriptParams(escapeHTML bool, params []any) []string {
 }
 
 // SafeScript encodes unknown parameters for safety for inside HTML attributes.
+//
+// Deprecated: Object parameters aren't protected against prototype pollution. Use
+// SafeScriptWithParams to pass an object parameter. Code generated for script templates
+// still uses SafeScript.
 func SafeScript(functionName string, params ...any) string {
 	encodedParams := safeEncodeScriptParams(true, params)
 	sb := new(strings.Builder)
@@ -571,6 +937,74 @@ func SafeScriptInline(functionName string, params ...any) string {
 	return sb.String()
 }
 
+// SafeScriptParams is an object parameter of a script. When encoded as JSON, keys that
+// can cause prototype pollution in the browser, i.e. keys starting with __, and the
+// constructor and prototype keys, are removed, including from nested maps.
+//
+// Pass SafeScriptParams to SafeScriptWithParams, or SafeScriptInline, in place of a map.
+type SafeScriptParams map[string]any
+
+// MarshalJSON encodes the params, without the keys that can cause prototype pollution.
+func (p SafeScriptParams) MarshalJSON() ([]byte, error) {
+	safe := make(map[string]any, len(p))
+	for k, v := range p {
+		if strings.HasPrefix(k, "__") || k == "constructor" || k == "prototype" {
+			continue
+		}
+		safe[k] = safeScriptParamValue(v)
+	}
+	return json.Marshal(safe)
+}
+
+// safeScriptParamValue returns the value with maps, including maps within slices, converted
+// to SafeScriptParams.
+func safeScriptParamValue(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		return SafeScriptParams(v)
+	case []map[string]any:
+		safe := make([]SafeScriptParams, len(v))
+		for i, m := range v {
+			safe[i] = SafeScriptParams(m)
+		}
+		return safe
+	case []any:
+		safe := make([]any, len(v))
+		for i, e := range v {
+			safe[i] = safeScriptParamValue(e)
+		}
+		return safe
+	}
+	return v
+}
+
+// SafeScriptWithParams encodes the params for safety inside HTML attributes, removing keys
+// that can cause prototype pollution, e.g. SafeScriptWithParams("init", SafeScriptParams{"id": 1})
+// returns init({&#34;id&#34;:1}).
+func SafeScriptWithParams(functionName string, params ...SafeScriptParams) string {
+	anyParams := make([]any, len(params))
+	for i, p := range params {
+		anyParams[i] = p
+	}
+	return SafeScript(functionName, anyParams...)
+}
+
+var javaScriptIdentifier = regexp.MustCompile(`^[a-zA-Z_$][a-zA-Z0-9_$]*$`)
+
+// SafeScriptNamed encodes the parameters as the properties of a single object parameter,
+// for safety inside HTML attributes, e.g. SafeScriptNamed("init", map[string]any{"id": 1})
+// returns init({&#34;id&#34;:1}). Keys that are not valid JavaScript identifiers are skipped,
+// and the params are encoded as SafeScriptParams.
+func SafeScriptNamed(functionName string, params map[string]any) string {
+	named := make(SafeScriptParams, len(params))
+	for k, v := range params {
+		if javaScriptIdentifier.MatchString(k) {
+			named[k] = v
+		}
+	}
+	return SafeScriptWithParams(functionName, named)
+}
+
 type contextKeyType int
 
 const contextKey = contextKeyType(0)
@@ -610,7 +1044,40 @@ func (v *contextValue) hasClassBeenRendered(s string) (ok bool) {
 	return
 }
 
+func (v *contextValue) addKeyframes(s string) {
+	if v.ss == nil {
+		v.ss = map[string]struct{}{}
+	}
+	v.ss["keyframes_"+s] = struct{}{}
+}
+
+func (v *contextValue) hasKeyframesBeenRendered(s string) (ok bool) {
+	if v.ss == nil {
+		v.ss = map[string]struct{}{}
+	}
+	_, ok = v.ss["keyframes_"+s]
+	return
+}
+
+func (v *contextValue) addCSSVar(s string) {
+	if v.ss == nil {
+		v.ss = map[string]struct{}{}
+	}
+	v.ss["cssvar_"+s] = struct{}{}
+}
+
+func (v *contextValue) hasCSSVarBeenRendered(s string) (ok bool) {
+	if v.ss == nil {
+		v.ss = map[string]struct{}{}
+	}
+	_, ok = v.ss["cssvar_"+s]
+	return
+}
+
 // InitializeContext initializes context used to store internal state used during rendering.
+//
+// If ctx has already been initialized, it's returned unchanged, so that the scripts and CSS
+// classes already rendered with it aren't rendered again.
 func InitializeContext(ctx context.Context) context.Context {
 	if _, ok := ctx.Value(contextKey).(*contextValue); ok {
 		return ctx
@@ -620,6 +1087,17 @@ func InitializeContext(ctx context.Context) context.Context {
 	return ctx
 }
 
+// MustInitializeContext is like InitializeContext, but panics if ctx contains a value of
+// an unexpected type under templ's context key. It's intended for use in tests.
+func MustInitializeContext(ctx context.Context) context.Context {
+	if v := ctx.Value(contextKey); v != nil {
+		if _, ok := v.(*contextValue); !ok {
+			panic(fmt.Sprintf("templ: context contains a value of unexpected type %T", v))
+		}
+	}
+	return InitializeContext(ctx)
+}
+
 func getContext(ctx context.Context) (context.Context, *contextValue) {
 	v, ok := ctx.Value(contextKey).(*contextValue)
 	if !ok {
@@ -659,6 +1137,11 @@ type ComponentScript struct {
 	// This is can be used to call the function inside a script tag:
 	//    <script>__templ_functionName_sha("some string",12345))</script>
 	CallInline string
+	// SourceURL is the name of the script shown in browser developer tools, e.g. print.js.
+	// In development mode, the script is rendered in its own script element, followed by a
+	// //# sourceURL= comment. It's ignored in production, and if it contains characters
+	// other than letters, digits, underscores, hyphens, periods and slashes.
+	SourceURL string
 }
 
 var _ Component = ComponentScript{}
@@ -688,18 +1171,34 @@ func RenderScriptItems(ctx context.Context, w io.Writer, scripts ...ComponentScr
 		return nil
 	}
 	_, v := getContext(ctx)
+	devMode := IsDevMode(ctx)
+	// Scripts are combined into a single script element, except for scripts annotated
+	// with a sourceURL in development mode, since the annotation applies to the element.
+	var elements []string
 	sb := new(strings.Builder)
 	for _, s := range scripts {
-		if !v.hasScriptBeenRendered(s.Name) {
+		if v.hasScriptBeenRendered(s.Name) {
+			continue
+		}
+		v.addScript(s.Name)
+		if !devMode || !scriptSourceURL.MatchString(s.SourceURL) {
 			sb.WriteString(s.Function)
-			v.addScript(s.Name)
+			continue
+		}
+		if sb.Len() > 0 {
+			elements = append(elements, sb.String())
+			sb.Reset()
 		}
+		elements = append(elements, s.Function+"\n//# sourceURL="+s.SourceURL)
 	}
 	if sb.Len() > 0 {
+		elements = append(elements, sb.String())
+	}
+	for _, e := range elements {
 		if _, err = io.WriteString(w, `<script type="text/javascript">`); err != nil {
 			return err
 		}
-		if _, err = io.WriteString(w, sb.String()); err != nil {
+		if _, err = io.WriteString(w, e); err != nil {
 			return err
 		}
 		if _, err = io.WriteString(w, `</script>`); err != nil {
@@ -709,6 +1208,8 @@ func RenderScriptItems(ctx context.Context, w io.Writer, scripts ...ComponentScr
 	return nil
 }
 
+var scriptSourceURL = regexp.MustCompile(`^[a-zA-Z0-9_./-]+$`)
+
 var bufferPool = sync.Pool{
 	New: func() any {
 		return new(bytes.Buffer)
@@ -751,6 +1252,60 @@ func (e Error) Unwrap() error {
 	return e.Err
 }
 
+// ComponentError is returned when a component fails to render. It identifies the
+// component that failed, and the components that it was rendered within.
+type ComponentError struct {
+	// Name of the component that failed to render.
+	Name string
+	// Err is the underlying error.
+	Err error
+	// Stack contains the names of the ancestor components, outermost first.
+	Stack []string
+}
+
+func (e *ComponentError) Error() string {
+	path := e.Name
+	if len(e.Stack) > 0 {
+		path = strings.Join(e.Stack, " > ") + " > " + e.Name
+	}
+	return fmt.Sprintf("templ: failed to render %s: %v", path, e.Err)
+}
+
+func (e *ComponentError) Unwrap() error {
+	return e.Err
+}
+
+// WrapComponentError is used by generated code to identify the component that returned err.
+// If err is already a ComponentError, the name is added to its Stack of ancestors.
+func WrapComponentError(name string, err error) error {
+	if err == nil {
+		return nil
+	}
+	if ce := UnwrapComponentError(err); ce != nil {
+		// The error may be shared, so a new ComponentError is returned instead of modifying it.
+		stack := make([]string, 0, len(ce.Stack)+1)
+		stack = append(stack, name)
+		stack = append(stack, ce.Stack...)
+		return &ComponentError{Name: ce.Name, Err: ce.Err, Stack: stack}
+	}
+	return &ComponentError{Name: name, Err: err}
+}
+
+// IsComponentError returns true if err is, or wraps, a ComponentError.
+func IsComponentError(err error) bool {
+	return UnwrapComponentError(err) != nil
+}
+
+// UnwrapComponentError returns the ComponentError within err, or nil if err does not
+// contain a ComponentError.
+func UnwrapComponentError(err error) *ComponentError {
+	var ce *ComponentError
+	if errors.As(err, &ce) {
+		return ce
+	}
+	return nil
+}
+
 // Raw renders the input HTML to the output without applying HTML escaping.
 //
 // Use of this component presents a security risk - the HTML should come from
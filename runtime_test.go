@@ -3,10 +3,12 @@ package templ_test
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"html/template"
 	"io"
+	"log/slog"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -212,6 +214,24 @@ func TestRenderCSS(t *testing.T) {
 			toRender: []any{c1, c2},
 			expected: ``,
 		},
+		{
+			name:     "media classes are rendered alongside classes with the same ID",
+			toIgnore: []any{c1},
+			toRender: []any{c1, templ.NewMediaCSSClass("c1", "(prefers-color-scheme: dark)", "color:white;")},
+			expected: `<style type="text/css">@media (prefers-color-scheme: dark){.c1{color:white;}}</style>`,
+		},
+		{
+			name:     "media classes are only rendered once",
+			toIgnore: []any{templ.NewMediaCSSClass("c1", "print", "color:black;")},
+			toRender: []any{templ.NewMediaCSSClass("c1", "print", "color:black;"), c2},
+			expected: `<style type="text/css">.c2{color:blue}</style>`,
+		},
+		{
+			name:     "supports classes are rendered alongside media classes with the same ID",
+			toIgnore: []any{c1, templ.NewMediaCSSClass("c1", "print", "color:black;")},
+			toRender: []any{c1, templ.NewMediaCSSClass("c1", "print", "color:black;"), templ.NewSupportsCSSClass("c1", "display: grid", "display:grid;")},
+			expected: `<style type="text/css">@supports (display: grid){.c1{display:grid;}}</style>`,
+		},
 		{
 			name:     "CSS classes are rendered",
 			toIgnore: nil,
@@ -246,6 +266,65 @@ func TestRenderCSS(t *testing.T) {
 	}
 }
 
+func TestNewMediaCSSClass(t *testing.T) {
+	tests := []struct {
+		name       string
+		mediaQuery string
+		expected   templ.SafeCSS
+	}{
+		{
+			name:       "valid media queries wrap the CSS",
+			mediaQuery: "screen and (min-width: 600px)",
+			expected:   `@media screen and (min-width: 600px){.wide{display:flex;}}`,
+		},
+		{
+			name:       "invalid media queries are replaced",
+			mediaQuery: "screen{}body{display:none}",
+			expected:   `@media zTemplUnsafeMediaQuery{.wide{display:flex;}}`,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			actual := templ.NewMediaCSSClass("wide", tt.mediaQuery, "display:flex;")
+			if actual.ClassName() != "wide" {
+				t.Errorf("expected the class name to be the id, got %q", actual.ClassName())
+			}
+			if diff := cmp.Diff(tt.expected, actual.Class); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestNewSupportsCSSClass(t *testing.T) {
+	tests := []struct {
+		name          string
+		supportsQuery string
+		expected      templ.SafeCSS
+	}{
+		{
+			name:          "valid conditions wrap the CSS",
+			supportsQuery: "display: grid",
+			expected:      `@supports (display: grid){.layout{display:grid;}}`,
+		},
+		{
+			name:          "invalid conditions are replaced",
+			supportsQuery: "display: grid){}body{display:none",
+			expected:      `@supports (zTemplUnsafeCSSPropertyName: zTemplUnsafeCSSPropertyValue){.layout{display:grid;}}`,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			actual := templ.NewSupportsCSSClass("layout", tt.supportsQuery, "display:grid;")
+			if diff := cmp.Diff(tt.expected, actual.Class); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
 func TestClassesFunction(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -353,6 +432,26 @@ func TestClassesFunction(t *testing.T) {
 			},
 			expected: "a b d",
 		},
+		{
+			name: "classes can be applied conditionally with MaybeClass",
+			input: []any{
+				"a",
+				templ.MaybeClass(true, "b"),
+				templ.MaybeClass(false, "c"),
+				templ.NopClass,
+			},
+			expected: "a b",
+		},
+		{
+			name: "empty class names are skipped",
+			input: []any{
+				"",
+				"a",
+				templ.SafeClass(""),
+				"b",
+			},
+			expected: "a b",
+		},
 		{
 			name: "the brackets on component CSS function calls can be elided",
 			input: []any{
@@ -376,6 +475,376 @@ func TestClassesFunction(t *testing.T) {
 	}
 }
 
+func TestCSSClassesMerge(t *testing.T) {
+	componentClass := templ.ComponentCSSClass{ID: "classA", Class: templ.SafeCSS(".classA{color:red;}")}
+	tests := []struct {
+		name     string
+		a        templ.CSSClasses
+		b        templ.CSSClasses
+		expected templ.CSSClasses
+	}{
+		{
+			name:     "empty classes",
+			expected: templ.CSSClasses{},
+		},
+		{
+			name:     "classes that are not in the receiver are appended",
+			a:        templ.Classes("a", "b"),
+			b:        templ.Classes("c"),
+			expected: templ.CSSClasses{"a", "b", "c"},
+		},
+		{
+			name:     "classes are deduplicated by class name",
+			a:        templ.Classes("a", componentClass),
+			b:        templ.Classes(templ.SafeClass("a"), "classA", "b", "b"),
+			expected: templ.CSSClasses{"a", componentClass, "b"},
+		},
+		{
+			name:     "conditional classes are always appended",
+			a:        templ.Classes("a"),
+			b:        templ.Classes(templ.KV("a", false)),
+			expected: templ.CSSClasses{"a", templ.KV("a", false)},
+		},
+		{
+			name:     "nested classes are flattened",
+			a:        templ.Classes("a", templ.Classes("b")),
+			b:        templ.Classes(templ.Classes("a", "b"), "c"),
+			expected: templ.CSSClasses{"a", "b", "c"},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if diff := cmp.Diff(tt.expected, tt.a.Merge(tt.b)); diff != "" {
+				t.Error(diff)
+			}
+			if diff := cmp.Diff(tt.expected, templ.MergeClasses(tt.a, tt.b)); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+	t.Run("the receiver is not modified", func(t *testing.T) {
+		a := make(templ.CSSClasses, 1, 10)
+		a[0] = "a"
+		merged := a.Merge(templ.Classes("b"))
+		_ = a.Merge(templ.Classes("c"))
+		if diff := cmp.Diff(templ.CSSClasses{"a", "b"}, merged); diff != "" {
+			t.Error(diff)
+		}
+		if diff := cmp.Diff(templ.CSSClasses{"a"}, a); diff != "" {
+			t.Error(diff)
+		}
+	})
+}
+
+func TestCSSClassesHas(t *testing.T) {
+	classes := templ.Classes(
+		"a",
+		templ.SafeClass("b"),
+		templ.ComponentCSSClass{ID: "classC", Class: templ.SafeCSS(".classC{color:red;}")},
+		templ.Classes("d"),
+		templ.KV("e", true),
+	)
+	tests := []struct {
+		name     string
+		expected bool
+	}{
+		{name: "a", expected: true},
+		{name: "b", expected: true},
+		{name: "classC", expected: true},
+		{name: "d", expected: true},
+		{name: "e", expected: false},
+		{name: "f", expected: false},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if actual := classes.Has(tt.name); actual != tt.expected {
+				t.Errorf("expected %v, got %v", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestCSSClassesFilter(t *testing.T) {
+	componentClass := templ.ComponentCSSClass{ID: "base", Class: templ.SafeCSS(".base{color:red;}")}
+	classes := templ.Classes("a", componentClass, templ.Classes("base", "c"), templ.KV("base", true))
+	filtered := classes.Filter(func(c templ.CSSClass) bool {
+		return c.ClassName() != "base"
+	})
+	expected := templ.CSSClasses{"a", templ.CSSClasses{"c"}, templ.KV("base", true)}
+	if diff := cmp.Diff(expected, filtered); diff != "" {
+		t.Error(diff)
+	}
+	if len(classes) != 4 || classes[1] != componentClass {
+		t.Error("expected the receiver not to be modified")
+	}
+	if actual := filtered.String(); actual != "a c base" {
+		t.Errorf("expected %q, got %q", "a c base", actual)
+	}
+}
+
+func TestCSSClassesUnique(t *testing.T) {
+	componentClass := templ.ComponentCSSClass{ID: "classA", Class: templ.SafeCSS(".classA{color:red;}")}
+	classes := templ.Classes("a", componentClass, templ.SafeClass("a"), "classA", templ.KV("b", true), templ.KV("b", true), "c", "a")
+	expected := templ.CSSClasses{"a", componentClass, templ.KV("b", true), templ.KV("b", true), "c"}
+	if diff := cmp.Diff(expected, classes.Unique()); diff != "" {
+		t.Error(diff)
+	}
+	if len(classes) != 8 {
+		t.Error("expected the receiver not to be modified")
+	}
+	t.Run("nested classes are flattened", func(t *testing.T) {
+		nested := templ.Classes("a", templ.Classes("a", "b", templ.Classes("b", "c")))
+		if diff := cmp.Diff(templ.CSSClasses{"a", "b", "c"}, nested.Unique()); diff != "" {
+			t.Error(diff)
+		}
+		if actual := templ.Classes("a", templ.Classes("a")).Unique().String(); actual != "a" {
+			t.Errorf("expected %q, got %q", "a", actual)
+		}
+	})
+}
+
+func TestCSSIDWithLength(t *testing.T) {
+	full := templ.CSSIDWithLength("className", "color:red;", 64)
+	tests := []struct {
+		name           string
+		length         int
+		expectedLength int
+	}{
+		{name: "longer lengths use more of the hash", length: 8, expectedLength: 8},
+		{name: "lengths are limited to the size of the hash", length: 100, expectedLength: 64},
+		{name: "lengths less than 1 use a single character", length: 0, expectedLength: 1},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			actual := templ.CSSIDWithLength("className", "color:red;", tt.length)
+			expected := full[:len("className_")+tt.expectedLength]
+			if diff := cmp.Diff(expected, actual); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+	t.Run("CSSID uses 4 characters", func(t *testing.T) {
+		if diff := cmp.Diff(full[:len("className_")+4], templ.CSSID("className", "color:red;")); diff != "" {
+			t.Error(diff)
+		}
+	})
+}
+
+func TestValidCSSClassName(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected bool
+	}{
+		{name: "button", expected: true},
+		{name: "btn-primary", expected: true},
+		{name: "_private", expected: true},
+		{name: "-prefixed", expected: true},
+		{name: "h1", expected: true},
+		{name: "", expected: false},
+		{name: "1column", expected: false},
+		{name: "--custom", expected: false},
+		{name: "two words", expected: false},
+		{name: "a{color:red}", expected: false},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if actual := templ.ValidCSSClassName(tt.name); actual != tt.expected {
+				t.Errorf("expected %v, got %v", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestSafeScriptNamed(t *testing.T) {
+	tests := []struct {
+		name     string
+		params   map[string]any
+		expected string
+	}{
+		{
+			name:     "no parameters",
+			params:   nil,
+			expected: `init({})`,
+		},
+		{
+			name:     "parameters are passed as an object, sorted by key",
+			params:   map[string]any{"title": "<b>", "count": 2, "$el": true},
+			expected: `init({&#34;$el&#34;:true,&#34;count&#34;:2,&#34;title&#34;:&#34;\u003cb\u003e&#34;})`,
+		},
+		{
+			name:     "invalid keys are skipped",
+			params:   map[string]any{"ok": 1, "not ok": 2, "1st": 3, `"});alert(1);({"`: 4},
+			expected: `init({&#34;ok&#34;:1})`,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if diff := cmp.Diff(tt.expected, templ.SafeScriptNamed("init", tt.params)); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestSafeScriptParams(t *testing.T) {
+	tests := []struct {
+		name     string
+		params   templ.SafeScriptParams
+		expected string
+	}{
+		{
+			name:     "keys that can cause prototype pollution are removed",
+			params:   templ.SafeScriptParams{"__proto__": map[string]any{"admin": true}, "constructor": 1, "prototype": 2, "__x": 3, "name": "a"},
+			expected: `update({&#34;name&#34;:&#34;a&#34;})`,
+		},
+		{
+			name:     "keys are removed from nested maps",
+			params:   templ.SafeScriptParams{"user": map[string]any{"__proto__": 1, "id": 1}},
+			expected: `update({&#34;user&#34;:{&#34;id&#34;:1}})`,
+		},
+		{
+			name:     "keys are removed from maps in slices",
+			params:   templ.SafeScriptParams{"users": []any{map[string]any{"__proto__": map[string]any{"admin": true}, "id": 1}, []any{map[string]any{"constructor": 1}}, 2}},
+			expected: `update({&#34;users&#34;:[{&#34;id&#34;:1},[{}],2]})`,
+		},
+		{
+			name:     "keys are removed from slices of maps",
+			params:   templ.SafeScriptParams{"users": []map[string]any{{"prototype": 1, "id": 1}}},
+			expected: `update({&#34;users&#34;:[{&#34;id&#34;:1}]})`,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if diff := cmp.Diff(tt.expected, templ.SafeScriptWithParams("update", tt.params)); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+	t.Run("SafeScriptNamed removes keys that can cause prototype pollution", func(t *testing.T) {
+		if diff := cmp.Diff(`update({})`, templ.SafeScriptNamed("update", map[string]any{"__proto__": 1})); diff != "" {
+			t.Error(diff)
+		}
+	})
+}
+
+func TestWithChildren(t *testing.T) {
+	text := func(s string) templ.Component {
+		return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+			_, err := io.WriteString(w, s)
+			return err
+		})
+	}
+	tests := []struct {
+		name     string
+		children []templ.Component
+		expected string
+	}{
+		{
+			name:     "if no children are passed, nothing is rendered",
+			children: nil,
+			expected: "",
+		},
+		{
+			name:     "a single child is rendered",
+			children: []templ.Component{text("a")},
+			expected: "a",
+		},
+		{
+			name:     "multiple children are rendered in sequence",
+			children: []templ.Component{text("a"), text("b"), text("c")},
+			expected: "abc",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := templ.WithChildren(context.Background(), tt.children...)
+			b := new(bytes.Buffer)
+			if err := templ.GetChildren(ctx).Render(ctx, b); err != nil {
+				t.Fatalf("failed to render children: %v", err)
+			}
+			if diff := cmp.Diff(tt.expected, b.String()); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+	t.Run("errors in multiple children are returned", func(t *testing.T) {
+		expectedErr := errors.New("child error")
+		ctx := templ.WithChildren(context.Background(), text("a"), templ.Raw("", expectedErr), text("c"))
+		b := new(bytes.Buffer)
+		if err := templ.GetChildren(ctx).Render(ctx, b); !errors.Is(err, expectedErr) {
+			t.Errorf("expected %v, got %v", expectedErr, err)
+		}
+		if diff := cmp.Diff("a", b.String()); diff != "" {
+			t.Error(diff)
+		}
+	})
+}
+
+func TestHasChildren(t *testing.T) {
+	child := templ.Raw("child")
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		expected bool
+	}{
+		{
+			name:     "a context without children has no children",
+			ctx:      context.Background(),
+			expected: false,
+		},
+		{
+			name:     "an initialized context without children has no children",
+			ctx:      templ.InitializeContext(context.Background()),
+			expected: false,
+		},
+		{
+			name:     "a context with a child has children",
+			ctx:      templ.WithChildren(context.Background(), child),
+			expected: true,
+		},
+		{
+			name:     "a context with multiple children has children",
+			ctx:      templ.WithChildren(context.Background(), child, child),
+			expected: true,
+		},
+		{
+			name:     "a context with zero children has no children",
+			ctx:      templ.WithChildren(context.Background()),
+			expected: false,
+		},
+		{
+			name:     "a context with the NopComponent set has no children",
+			ctx:      templ.WithChildren(context.Background(), templ.NopComponent),
+			expected: false,
+		},
+		{
+			name:     "a context with nil children has no children",
+			ctx:      templ.WithChildren(context.Background(), nil),
+			expected: false,
+		},
+		{
+			name:     "a context with cleared children has no children",
+			ctx:      templ.ClearChildren(templ.WithChildren(context.Background(), child)),
+			expected: false,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if actual := templ.HasChildren(tt.ctx); actual != tt.expected {
+				t.Errorf("expected %v, got %v", tt.expected, actual)
+			}
+		})
+	}
+}
+
 func TestHandler(t *testing.T) {
 	hello := templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
 		if _, err := io.WriteString(w, "Hello"); err != nil {
@@ -472,6 +941,125 @@ func TestHandler(t *testing.T) {
 	}
 }
 
+func TestHandlerLogger(t *testing.T) {
+	hello := templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+		_, err := io.WriteString(w, "Hello")
+		return err
+	})
+	errorComponent := templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+		return errors.New("handler error")
+	})
+
+	tests := []struct {
+		name           string
+		input          templ.Component
+		options        []func(*templ.ComponentHandler)
+		expectedLevel  string
+		expectedStatus float64
+		expectedError  string
+	}{
+		{
+			name:           "successful renders are logged at INFO level",
+			input:          hello,
+			expectedLevel:  "INFO",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "the configured status code is logged",
+			input:          hello,
+			options:        []func(*templ.ComponentHandler){templ.WithStatus(http.StatusNotFound)},
+			expectedLevel:  "INFO",
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "failed renders are logged at ERROR level",
+			input:          errorComponent,
+			expectedLevel:  "ERROR",
+			expectedStatus: http.StatusInternalServerError,
+			expectedError:  "handler error",
+		},
+		{
+			name:  "the status written by custom error handlers is logged",
+			input: errorComponent,
+			options: []func(*templ.ComponentHandler){templ.WithErrorHandler(func(r *http.Request, err error) http.Handler {
+				return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusBadRequest)
+				})
+			})},
+			expectedLevel:  "ERROR",
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "handler error",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			log := new(bytes.Buffer)
+			options := append(tt.options, templ.WithLogger(slog.New(slog.NewJSONHandler(log, nil))))
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("POST", "/test", nil)
+			templ.Handler(tt.input, options...).ServeHTTP(w, r)
+
+			var entry map[string]any
+			if err := json.Unmarshal(log.Bytes(), &entry); err != nil {
+				t.Fatalf("failed to parse log entry %q: %v", log.String(), err)
+			}
+			if entry["level"] != tt.expectedLevel {
+				t.Errorf("expected level %q, got %q", tt.expectedLevel, entry["level"])
+			}
+			if entry["method"] != "POST" {
+				t.Errorf("expected method %q, got %q", "POST", entry["method"])
+			}
+			if entry["path"] != "/test" {
+				t.Errorf("expected path %q, got %q", "/test", entry["path"])
+			}
+			if entry["status"] != tt.expectedStatus {
+				t.Errorf("expected status %v, got %v", tt.expectedStatus, entry["status"])
+			}
+			if _, ok := entry["duration_ms"]; !ok {
+				t.Error("expected duration_ms to be logged")
+			}
+			if tt.expectedError == "" {
+				if _, ok := entry["error"]; ok {
+					t.Errorf("unexpected error logged: %v", entry["error"])
+				}
+				return
+			}
+			if entry["error"] != tt.expectedError {
+				t.Errorf("expected error %q, got %q", tt.expectedError, entry["error"])
+			}
+		})
+	}
+}
+
+func TestHandlerLoggerFlush(t *testing.T) {
+	errorComponent := templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+		return errors.New("handler error")
+	})
+	var flusherErr, controllerErr error
+	errorHandler := templ.WithErrorHandler(func(r *http.Request, err error) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if _, ok := w.(http.Flusher); !ok {
+				flusherErr = errors.New("expected the http.ResponseWriter to implement http.Flusher")
+			}
+			controllerErr = http.NewResponseController(w).Flush()
+		})
+	})
+	log := new(bytes.Buffer)
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest("GET", "/test", nil)
+	templ.Handler(errorComponent, errorHandler, templ.WithLogger(slog.New(slog.NewJSONHandler(log, nil)))).ServeHTTP(w, r)
+	if flusherErr != nil {
+		t.Error(flusherErr)
+	}
+	if controllerErr != nil {
+		t.Errorf("failed to flush with http.ResponseController: %v", controllerErr)
+	}
+	if !w.Flushed {
+		t.Error("expected the underlying http.ResponseWriter to be flushed")
+	}
+}
+
 func TestRenderScriptItems(t *testing.T) {
 	s1 := templ.ComponentScript{
 		Name:     "s1",
@@ -481,8 +1069,14 @@ func TestRenderScriptItems(t *testing.T) {
 		Name:     "s2",
 		Function: "function s2() { return 'hello2'; }",
 	}
+	s3 := templ.ComponentScript{
+		Name:      "s3",
+		Function:  "function s3() { return 'hello3'; }",
+		SourceURL: "components/s3.js",
+	}
 	tests := []struct {
 		name     string
+		devMode  bool
 		toIgnore []templ.ComponentScript
 		toRender []templ.ComponentScript
 		expected string
@@ -523,11 +1117,30 @@ func TestRenderScriptItems(t *testing.T) {
 			toRender: []templ.ComponentScript{s1, s2},
 			expected: ``,
 		},
+		{
+			name:     "source URLs are ignored in production",
+			toRender: []templ.ComponentScript{s1, s3, s2},
+			expected: `<script type="text/javascript">` + s1.Function + s3.Function + s2.Function + `</script>`,
+		},
+		{
+			name:     "in dev mode, scripts with source URLs are rendered in their own element",
+			devMode:  true,
+			toRender: []templ.ComponentScript{s1, s3, s2},
+			expected: `<script type="text/javascript">` + s1.Function + `</script>` +
+				`<script type="text/javascript">` + s3.Function + "\n//# sourceURL=components/s3.js" + `</script>` +
+				`<script type="text/javascript">` + s2.Function + `</script>`,
+		},
+		{
+			name:     "in dev mode, invalid source URLs are ignored",
+			devMode:  true,
+			toRender: []templ.ComponentScript{s1, {Name: "s4", Function: "s4();", SourceURL: "s4.js\nalert(1)"}},
+			expected: `<script type="text/javascript">` + s1.Function + "s4();" + `</script>`,
+		},
 	}
 	for _, tt := range tests {
 		tt := tt
 		t.Run(tt.name, func(t *testing.T) {
-			ctx := context.Background()
+			ctx := templ.WithDevMode(context.Background(), tt.devMode)
 			b := new(bytes.Buffer)
 
 			// Render twice, reusing the same context so that there's a memory of which classes have been rendered.
@@ -593,6 +1206,67 @@ func TestErrorWrapping(t *testing.T) {
 	})
 }
 
+func TestComponentError(t *testing.T) {
+	errSomethingBad := errors.New("bad error")
+	t.Run("nil errors are not wrapped", func(t *testing.T) {
+		if err := templ.WrapComponentError("Leaf", nil); err != nil {
+			t.Errorf("expected nil, got %v", err)
+		}
+	})
+	t.Run("errors are wrapped with the component name", func(t *testing.T) {
+		err := templ.WrapComponentError("Leaf", errSomethingBad)
+		if !templ.IsComponentError(err) {
+			t.Fatalf("expected a component error, got %T", err)
+		}
+		if !errors.Is(err, errSomethingBad) {
+			t.Errorf("expected the underlying error to be unwrapped")
+		}
+		if diff := cmp.Diff("templ: failed to render Leaf: bad error", err.Error()); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("ancestors are added to the stack, outermost first", func(t *testing.T) {
+		err := templ.WrapComponentError("Leaf", errSomethingBad)
+		err = templ.WrapComponentError("Layout", err)
+		err = fmt.Errorf("context: %w", err)
+		err = templ.WrapComponentError("Page", err)
+		ce := templ.UnwrapComponentError(err)
+		if ce == nil {
+			t.Fatalf("expected a component error, got %T", err)
+		}
+		if ce.Name != "Leaf" {
+			t.Errorf("expected name %q, got %q", "Leaf", ce.Name)
+		}
+		if diff := cmp.Diff([]string{"Page", "Layout"}, ce.Stack); diff != "" {
+			t.Error(diff)
+		}
+		if diff := cmp.Diff("templ: failed to render Page > Layout > Leaf: bad error", ce.Error()); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("wrapping a shared error does not modify it", func(t *testing.T) {
+		shared := templ.WrapComponentError("Leaf", errSomethingBad)
+		first := templ.WrapComponentError("Page", shared)
+		second := templ.WrapComponentError("Page", shared)
+		if diff := cmp.Diff("templ: failed to render Leaf: bad error", shared.Error()); diff != "" {
+			t.Error(diff)
+		}
+		for _, err := range []error{first, second} {
+			if diff := cmp.Diff("templ: failed to render Page > Leaf: bad error", err.Error()); diff != "" {
+				t.Error(diff)
+			}
+		}
+	})
+	t.Run("other errors are not component errors", func(t *testing.T) {
+		if templ.IsComponentError(errSomethingBad) {
+			t.Error("expected false")
+		}
+		if ce := templ.UnwrapComponentError(errSomethingBad); ce != nil {
+			t.Errorf("expected nil, got %v", ce)
+		}
+	})
+}
+
 func TestRawComponent(t *testing.T) {
 	tests := []struct {
 		name        string
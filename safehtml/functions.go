@@ -0,0 +1,161 @@
+package safehtml
+
+import (
+	"regexp"
+	"strings"
+)
+
+// CalcFallbackValue replaces calc() expressions that fail sanitization.
+const CalcFallbackValue = "0px"
+
+// VarFallbackValue replaces var() expressions that fail sanitization.
+const VarFallbackValue = "var(--templ-fallback)"
+
+type cssFunction struct {
+	prefix   string
+	isSafe   func(expr string) bool
+	fallback string
+}
+
+var cssFunctions = []cssFunction{
+	{prefix: "calc(", isSafe: calcIsSafe, fallback: CalcFallbackValue},
+	{prefix: "var(", isSafe: varIsSafe, fallback: VarFallbackValue},
+}
+
+// containsCSSFunction returns true if the value contains a function sanitized by sanitizeFunctions.
+func containsCSSFunction(value string) bool {
+	for _, f := range cssFunctions {
+		if strings.Contains(value, f.prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// sanitizeFunctions sanitizes a value containing calc() and var() expressions. Each expression
+// that fails sanitization is replaced with a fallback value. The rest of the value is sanitized
+// as normal.
+func sanitizeFunctions(property, value string) string {
+	var sb strings.Builder
+	// check is the value with each expression replaced by 0, used to sanitize
+	// the rest of the value.
+	var check strings.Builder
+	rest := value
+	for {
+		i, f := nextCSSFunction(rest)
+		if i < 0 {
+			break
+		}
+		if i > 0 && isIdentifierByte(rest[i-1]) {
+			// e.g. xcalc(, which is a different function.
+			return InnocuousPropertyValue
+		}
+		end := matchingParen(rest, i+len(f.prefix)-1)
+		if end < 0 {
+			return InnocuousPropertyValue
+		}
+		expr := rest[i : end+1]
+		if !f.isSafe(expr) {
+			expr = f.fallback
+		}
+		sb.WriteString(rest[:i])
+		sb.WriteString(expr)
+		check.WriteString(rest[:i])
+		check.WriteString("0")
+		rest = rest[end+1:]
+	}
+	sb.WriteString(rest)
+	check.WriteString(rest)
+	if SanitizeCSSValue(property, check.String()) == InnocuousPropertyValue {
+		return InnocuousPropertyValue
+	}
+	return sb.String()
+}
+
+// nextCSSFunction returns the index of the first sanitized function in s, or -1.
+func nextCSSFunction(s string) (index int, f cssFunction) {
+	index = -1
+	for _, candidate := range cssFunctions {
+		if i := strings.Index(s, candidate.prefix); i >= 0 && (index < 0 || i < index) {
+			index, f = i, candidate
+		}
+	}
+	return index, f
+}
+
+func isIdentifierByte(b byte) bool {
+	return b == '-' || b == '_' || (b >= '0' && b <= '9') || (b >= 'a' && b <= 'z') || (b >= 'A' && b <= 'Z')
+}
+
+// matchingParen returns the index of the parenthesis that closes the parenthesis at open,
+// or -1 if it's not closed.
+func matchingParen(s string, open int) int {
+	var depth int
+	for i := open; i < len(s); i++ {
+		switch s[i] {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// CustomPropertyNamePattern matches valid CSS custom property names, e.g. --main-color.
+var CustomPropertyNamePattern = regexp.MustCompile(`^--[a-zA-Z_][a-zA-Z0-9_-]*$`)
+
+var varExpression = regexp.MustCompile(`^var\((--[a-zA-Z_][a-zA-Z0-9_-]*)\)$`)
+
+// varIsSafe returns true if the var() expression is a reference to a valid custom property name.
+// Fallback values are not supported.
+func varIsSafe(expr string) bool {
+	return varExpression.MatchString(expr)
+}
+
+var calcToken = regexp.MustCompile(`^(?:calc\(|var\(--[a-zA-Z_][a-zA-Z0-9_-]*\)|[()+\-*/]|(?:[0-9]+(?:\.[0-9]+)?|\.[0-9]+)([a-zA-Z]+|%)?)`)
+
+var calcUnits = map[string]struct{}{
+	"": {}, "%": {}, "px": {}, "em": {}, "rem": {}, "ex": {}, "ch": {}, "lh": {}, "rlh": {},
+	"vw": {}, "vh": {}, "vmin": {}, "vmax": {}, "svw": {}, "svh": {}, "lvw": {}, "lvh": {}, "dvw": {}, "dvh": {},
+	"cqw": {}, "cqh": {}, "cqi": {}, "cqb": {}, "cqmin": {}, "cqmax": {},
+	"cm": {}, "mm": {}, "q": {}, "in": {}, "pt": {}, "pc": {},
+	"deg": {}, "grad": {}, "rad": {}, "turn": {}, "s": {}, "ms": {}, "hz": {}, "khz": {},
+	"dpi": {}, "dpcm": {}, "dppx": {}, "x": {}, "fr": {},
+}
+
+// calcIsSafe returns true if the calc() expression only contains numbers, units, operators,
+// parentheses, var() references and nested calc() expressions.
+func calcIsSafe(expr string) bool {
+	if strings.Contains(expr, "/*") || strings.Contains(expr, "*/") {
+		return false
+	}
+	s := expr
+	var depth int
+	for {
+		s = strings.TrimLeft(s, " \t")
+		if s == "" {
+			return depth == 0
+		}
+		m := calcToken.FindStringSubmatch(s)
+		if m == nil {
+			return false
+		}
+		if _, ok := calcUnits[strings.ToLower(m[1])]; !ok {
+			return false
+		}
+		switch {
+		case m[0] == "calc(" || m[0] == "(":
+			depth++
+		case m[0] == ")":
+			depth--
+			if depth < 0 {
+				return false
+			}
+		}
+		s = s[len(m[0]):]
+	}
+}
@@ -0,0 +1,81 @@
+package safehtml
+
+import (
+	"regexp"
+	"strings"
+)
+
+// InnocuousMediaQuery is an innocuous media query generated by a sanitizer when its input
+// is unsafe. It's parsed as an unknown media type, so it never matches.
+const InnocuousMediaQuery = "zTemplUnsafeMediaQuery"
+
+// mediaFeatures are the media features allowed in media queries.
+var mediaFeatures = map[string]struct{}{
+	"any-hover":                    {},
+	"any-pointer":                  {},
+	"aspect-ratio":                 {},
+	"color":                        {},
+	"color-gamut":                  {},
+	"display-mode":                 {},
+	"forced-colors":                {},
+	"height":                       {},
+	"hover":                        {},
+	"max-aspect-ratio":             {},
+	"max-color":                    {},
+	"max-height":                   {},
+	"max-resolution":               {},
+	"max-width":                    {},
+	"min-aspect-ratio":             {},
+	"min-color":                    {},
+	"min-height":                   {},
+	"min-resolution":               {},
+	"min-width":                    {},
+	"monochrome":                   {},
+	"orientation":                  {},
+	"pointer":                      {},
+	"prefers-color-scheme":         {},
+	"prefers-contrast":             {},
+	"prefers-reduced-motion":       {},
+	"prefers-reduced-transparency": {},
+	"resolution":                   {},
+	"scripting":                    {},
+	"update":                       {},
+	"width":                        {},
+}
+
+const mediaCondition = `\(\s*([a-z-]+)\s*(?::\s*([^()]*?))?\s*\)`
+
+// mediaQueryPattern matches a single media query, e.g. "screen and (min-width: 600px)",
+// or "(prefers-color-scheme: dark)".
+var mediaQueryPattern = regexp.MustCompile(`^(?:(?:(?:not|only)\s+)?(?:all|screen|print)(?:\s+and\s+` + mediaCondition + `)*|` +
+	mediaCondition + `(?:\s+and\s+` + mediaCondition + `)*)$`)
+
+var mediaConditionPattern = regexp.MustCompile(mediaCondition)
+
+// mediaFeatureValuePattern matches lengths, resolutions, ratios and keywords.
+var mediaFeatureValuePattern = regexp.MustCompile(`^(?:[0-9]+(?:\.[0-9]+)?(?:px|em|rem|vw|vh|dpi|dpcm|dppx|x)?|[0-9]+\s*/\s*[0-9]+|[a-z][a-z-]*)$`)
+
+// SanitizeMediaQuery returns the media query list if it only contains the all, screen and
+// print media types, and known media features with length, resolution, ratio or keyword
+// values. Otherwise, InnocuousMediaQuery is returned.
+func SanitizeMediaQuery(query string) string {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return InnocuousMediaQuery
+	}
+	for _, q := range strings.Split(query, ",") {
+		q = strings.ToLower(strings.TrimSpace(q))
+		if !mediaQueryPattern.MatchString(q) {
+			return InnocuousMediaQuery
+		}
+		for _, m := range mediaConditionPattern.FindAllStringSubmatch(q, -1) {
+			if _, ok := mediaFeatures[m[1]]; !ok {
+				return InnocuousMediaQuery
+			}
+			if m[2] != "" && !mediaFeatureValuePattern.MatchString(m[2]) {
+				return InnocuousMediaQuery
+			}
+		}
+	}
+	return query
+}
@@ -0,0 +1,34 @@
+package safehtml
+
+import "testing"
+
+func TestSanitizeMediaQuery(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{name: "media features are allowed", input: "(prefers-color-scheme: dark)", expected: "(prefers-color-scheme: dark)"},
+		{name: "media types are allowed", input: "print", expected: "print"},
+		{name: "media types can be combined with features", input: "only screen and (min-width: 600px) and (max-width: 40em)", expected: "only screen and (min-width: 600px) and (max-width: 40em)"},
+		{name: "features without values are allowed", input: "(hover)", expected: "(hover)"},
+		{name: "ratios are allowed", input: "(min-aspect-ratio: 16/9)", expected: "(min-aspect-ratio: 16/9)"},
+		{name: "resolutions are allowed", input: "(min-resolution: 2dppx)", expected: "(min-resolution: 2dppx)"},
+		{name: "lists of queries are allowed", input: "print, (orientation: landscape)", expected: "print, (orientation: landscape)"},
+		{name: "empty queries are not allowed", input: "", expected: InnocuousMediaQuery},
+		{name: "unknown features are not allowed", input: "(unknown: 1px)", expected: InnocuousMediaQuery},
+		{name: "unknown media types are not allowed", input: "tv", expected: InnocuousMediaQuery},
+		{name: "braces are not allowed", input: "(min-width: 1px){}body{color:red}", expected: InnocuousMediaQuery},
+		{name: "braces in values are not allowed", input: "(min-width: 1px}{)", expected: InnocuousMediaQuery},
+		{name: "comments are not allowed", input: "(min-width: /**/1px)", expected: InnocuousMediaQuery},
+		{name: "functions are not allowed", input: "(min-width: calc(1px))", expected: InnocuousMediaQuery},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if actual := SanitizeMediaQuery(tt.input); actual != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, actual)
+			}
+		})
+	}
+}
@@ -24,6 +24,9 @@ func SanitizeCSS(property, value string) (string, string) {
 }
 
 func SanitizeCSSValue(property, value string) string {
+	if containsCSSFunction(value) {
+		return sanitizeFunctions(property, value)
+	}
 	if sanitizer, ok := cssPropertyNameToValueSanitizer[property]; ok {
 		return sanitizer(value)
 	}
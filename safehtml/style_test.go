@@ -346,6 +346,118 @@ func TestSanitizeCSS(t *testing.T) {
 			inputValue:       "*+/-.!#%_ \t",
 			expectedValue:    InnocuousPropertyValue,
 		},
+		{
+			name:             "calc expressions are allowed",
+			inputProperty:    "width",
+			expectedProperty: "width",
+			inputValue:       "calc(100% - 2em)",
+			expectedValue:    "calc(100% - 2em)",
+		},
+		{
+			name:             "calc expressions can be nested and grouped",
+			inputProperty:    "padding",
+			expectedProperty: "padding",
+			inputValue:       "1px calc((100vw - calc(2 * 1.5rem)) / 3) 0",
+			expectedValue:    "1px calc((100vw - calc(2 * 1.5rem)) / 3) 0",
+		},
+		{
+			name:             "calc expressions cannot contain functions",
+			inputProperty:    "width",
+			expectedProperty: "width",
+			inputValue:       "calc(expression(alert(1)))",
+			expectedValue:    CalcFallbackValue,
+		},
+		{
+			name:             "calc expressions cannot contain unknown units",
+			inputProperty:    "width",
+			expectedProperty: "width",
+			inputValue:       "calc(100% - 2javascript)",
+			expectedValue:    CalcFallbackValue,
+		},
+		{
+			name:             "calc expressions cannot contain comments",
+			inputProperty:    "width",
+			expectedProperty: "width",
+			inputValue:       "calc(1px /**/ + 2px)",
+			expectedValue:    CalcFallbackValue,
+		},
+		{
+			name:             "calc expressions cannot contain other characters",
+			inputProperty:    "width",
+			expectedProperty: "width",
+			inputValue:       "calc(1px;color:red)",
+			expectedValue:    CalcFallbackValue,
+		},
+		{
+			name:             "only the unsafe calc expressions are replaced",
+			inputProperty:    "margin",
+			expectedProperty: "margin",
+			inputValue:       "calc(1px + 1px) calc(url(x))",
+			expectedValue:    "calc(1px + 1px) " + CalcFallbackValue,
+		},
+		{
+			name:             "unterminated calc expressions are not allowed",
+			inputProperty:    "width",
+			expectedProperty: "width",
+			inputValue:       "calc(1px + 1px",
+			expectedValue:    InnocuousPropertyValue,
+		},
+		{
+			name:             "values around calc expressions are sanitized",
+			inputProperty:    "width",
+			expectedProperty: "width",
+			inputValue:       "calc(1px) ;color:red",
+			expectedValue:    InnocuousPropertyValue,
+		},
+		{
+			name:             "var references are allowed",
+			inputProperty:    "color",
+			expectedProperty: "color",
+			inputValue:       "var(--main-color)",
+			expectedValue:    "var(--main-color)",
+		},
+		{
+			name:             "var references can be used in calc expressions",
+			inputProperty:    "width",
+			expectedProperty: "width",
+			inputValue:       "calc(100% - var(--_gap-2))",
+			expectedValue:    "calc(100% - var(--_gap-2))",
+		},
+		{
+			name:             "var references must have valid names",
+			inputProperty:    "color",
+			expectedProperty: "color",
+			inputValue:       "var(--1st)",
+			expectedValue:    VarFallbackValue,
+		},
+		{
+			name:             "var references must start with two hyphens",
+			inputProperty:    "color",
+			expectedProperty: "color",
+			inputValue:       "var(main-color)",
+			expectedValue:    VarFallbackValue,
+		},
+		{
+			name:             "var references cannot contain other expressions",
+			inputProperty:    "color",
+			expectedProperty: "color",
+			inputValue:       "var(--x, url(javascript:alert(1)))",
+			expectedValue:    VarFallbackValue,
+		},
+		{
+			name:             "invalid var references in calc expressions are not allowed",
+			inputProperty:    "width",
+			expectedProperty: "width",
+			inputValue:       "calc(1px + var(--a;b))",
+			expectedValue:    CalcFallbackValue,
+		},
+		{
+			name:             "functions with names ending in var are not allowed",
+			inputProperty:    "color",
+			expectedProperty: "color",
+			inputValue:       "xvar(--a)",
+			expectedValue:    InnocuousPropertyValue,
+		},
 	}
 	for _, tt := range tests {
 		tt := tt
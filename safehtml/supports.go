@@ -0,0 +1,68 @@
+package safehtml
+
+import "strings"
+
+// InnocuousSupportsCondition is an innocuous supports condition generated by a sanitizer
+// when its input is unsafe. The browser doesn't support the property, so it never matches.
+const InnocuousSupportsCondition = "(" + InnocuousPropertyName + ": " + InnocuousPropertyValue + ")"
+
+// SanitizeSupportsCondition returns the condition of an @supports rule, wrapped in
+// parentheses, e.g. "display: grid" becomes "(display: grid)".
+//
+// The condition can be a declaration, or declarations in parentheses combined with not,
+// and, and or. Each declaration must pass the same sanitization as property values.
+// Otherwise, InnocuousSupportsCondition is returned.
+func SanitizeSupportsCondition(condition string) string {
+	condition = "(" + strings.TrimSpace(condition) + ")"
+	if strings.ContainsAny(condition, "{};") || !validSupportsCondition(condition) {
+		return InnocuousSupportsCondition
+	}
+	return condition
+}
+
+// validSupportsCondition returns true if s is one or more conditions in parentheses,
+// optionally negated with not, and combined with and, or or.
+func validSupportsCondition(s string) bool {
+	s = strings.TrimSpace(s)
+	for {
+		s = strings.TrimPrefix(s, "not ")
+		s = strings.TrimSpace(s)
+		if !strings.HasPrefix(s, "(") {
+			return false
+		}
+		end := matchingParen(s, 0)
+		if end < 0 || !validSupportsInParens(s[1:end]) {
+			return false
+		}
+		s = strings.TrimSpace(s[end+1:])
+		if s == "" {
+			return true
+		}
+		var ok bool
+		if s, ok = strings.CutPrefix(s, "and "); ok {
+			continue
+		}
+		if s, ok = strings.CutPrefix(s, "or "); ok {
+			continue
+		}
+		return false
+	}
+}
+
+// validSupportsInParens returns true if s, the contents of a pair of parentheses, is a
+// nested condition, or a declaration with a safe property and value.
+func validSupportsInParens(s string) bool {
+	s = strings.TrimSpace(s)
+	if strings.HasPrefix(s, "(") || strings.HasPrefix(s, "not ") {
+		return validSupportsCondition(s)
+	}
+	property, value, ok := strings.Cut(s, ":")
+	if !ok {
+		return false
+	}
+	property = SanitizeCSSProperty(strings.TrimSpace(property))
+	if property == InnocuousPropertyName {
+		return false
+	}
+	return SanitizeCSSValue(property, strings.TrimSpace(value)) != InnocuousPropertyValue
+}
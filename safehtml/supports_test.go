@@ -0,0 +1,31 @@
+package safehtml
+
+import "testing"
+
+func TestSanitizeSupportsCondition(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{name: "declarations are wrapped in parentheses", input: "display: grid", expected: "(display: grid)"},
+		{name: "conditions can be combined", input: "(display: grid) and (gap: 1em)", expected: "((display: grid) and (gap: 1em))"},
+		{name: "conditions can be negated", input: "not (display: grid)", expected: "(not (display: grid))"},
+		{name: "conditions can be nested", input: "(display: grid) or ((display: flex) and (not (gap: 1em)))", expected: "((display: grid) or ((display: flex) and (not (gap: 1em))))"},
+		{name: "values can contain functions", input: "width: calc(1px + 1em)", expected: "(width: calc(1px + 1em))"},
+		{name: "empty conditions are not allowed", input: "", expected: InnocuousSupportsCondition},
+		{name: "unsafe values are not allowed", input: "background: url(javascript:alert(1))", expected: InnocuousSupportsCondition},
+		{name: "unsafe properties are not allowed", input: "x/**/y: 1", expected: InnocuousSupportsCondition},
+		{name: "braces are not allowed", input: "display: grid){}body{color:red", expected: InnocuousSupportsCondition},
+		{name: "unbalanced parentheses are not allowed", input: "(display: grid", expected: InnocuousSupportsCondition},
+		{name: "unknown operators are not allowed", input: "(display: grid) xor (gap: 1em)", expected: InnocuousSupportsCondition},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if actual := SanitizeSupportsCondition(tt.input); actual != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package templ
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+)
+
+// ScrollSection is a section of the page observed by a scroll spy.
+type ScrollSection struct {
+	// ID of the section element.
+	ID string `json:"id"`
+	// NavLinkSelector is a CSS selector, relative to the navigation element, that selects
+	// the link to the section, e.g. a[href="#install"].
+	NavLinkSelector string `json:"navLinkSelector"`
+}
+
+// NewScrollSpy adds the active class to the link of the section that is currently at the
+// top of the viewport, and removes it from the other links. The links must be within the
+// element with the id navID.
+func NewScrollSpy(navID string, sections []ScrollSection) Component {
+	return ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		s := sections
+		if s == nil {
+			s = []ScrollSection{}
+		}
+		config, err := json.Marshal(s)
+		if err != nil {
+			return err
+		}
+		if err = writeStrings(w, `<div data-scroll-spy="`, EscapeString(navID), `" data-scroll-spy-sections="`, EscapeString(string(config)), `" hidden></div>`); err != nil {
+			return err
+		}
+		return scrollSpyScript.Render(ctx, w)
+	})
+}
+
+var scrollSpyScript = ComponentScript{
+	Name: `__templ_scrollSpy`,
+	Function: `(function(){` +
+		`if(!window.IntersectionObserver){return;}` +
+		`function setup(el){` +
+		`if(el.hasAttribute("data-scroll-spy-ready")){return;}el.setAttribute("data-scroll-spy-ready","");` +
+		`var nav=document.getElementById(el.getAttribute("data-scroll-spy"));if(!nav){return;}` +
+		`var links={};JSON.parse(el.getAttribute("data-scroll-spy-sections")).forEach(function(s){` +
+		`try{links[s.id]=nav.querySelector(s.navLinkSelector);}catch(e){}});` +
+		`var observer=new IntersectionObserver(function(entries){entries.forEach(function(entry){` +
+		`if(!entry.isIntersecting||!links[entry.target.id]){return;}` +
+		`Object.keys(links).forEach(function(id){if(links[id]){links[id].classList.toggle("active",id===entry.target.id);}});` +
+		`});},{rootMargin:"0px 0px -70% 0px"});` +
+		`Object.keys(links).forEach(function(id){var section=document.getElementById(id);if(section){observer.observe(section);}});` +
+		`}` +
+		// Sections are usually rendered after the spy, so they're observed once the document
+		// has loaded.
+		`function scan(){document.querySelectorAll("[data-scroll-spy]").forEach(setup);}` +
+		`if(document.readyState==="loading"){document.addEventListener("DOMContentLoaded",scan);}else{scan();}` +
+		`})();`,
+}
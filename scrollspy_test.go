@@ -0,0 +1,52 @@
+package templ_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestScrollSpy(t *testing.T) {
+	tests := []struct {
+		name     string
+		sections []templ.ScrollSection
+		expected string
+	}{
+		{
+			name: "sections are JSON encoded",
+			sections: []templ.ScrollSection{
+				{ID: "install", NavLinkSelector: `a[href="#install"]`},
+				{ID: "usage", NavLinkSelector: `a[href="#usage"]`},
+			},
+			expected: `<div data-scroll-spy="docs-nav" data-scroll-spy-sections="[{&#34;id&#34;:&#34;install&#34;,&#34;navLinkSelector&#34;:&#34;a[href=\&#34;#install\&#34;]&#34;},` +
+				`{&#34;id&#34;:&#34;usage&#34;,&#34;navLinkSelector&#34;:&#34;a[href=\&#34;#usage\&#34;]&#34;}]" hidden></div>`,
+		},
+		{
+			name:     "no sections",
+			expected: `<div data-scroll-spy="docs-nav" data-scroll-spy-sections="[]" hidden></div>`,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			b := new(bytes.Buffer)
+			if err := templ.NewScrollSpy("docs-nav", tt.sections).Render(context.Background(), b); err != nil {
+				t.Fatalf("failed to render: %v", err)
+			}
+			html, script, _ := strings.Cut(b.String(), "<script")
+			if diff := cmp.Diff(tt.expected, html); diff != "" {
+				t.Error(diff)
+			}
+			if !strings.Contains(script, "IntersectionObserver") {
+				t.Errorf("expected the script to be rendered, got %q", script)
+			}
+			if !strings.Contains(script, `document.addEventListener("DOMContentLoaded",scan)`) {
+				t.Errorf("expected sections to be observed once the document has loaded, got %q", script)
+			}
+		})
+	}
+}
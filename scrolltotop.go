@@ -0,0 +1,36 @@
+package templ
+
+import (
+	"context"
+	"io"
+)
+
+var scrollToTopClass = newComponentCSSRules("scrollToTop",
+	`&{position:fixed;right:1em;bottom:1em;padding:0.5em 0.75em;border:none;border-radius:50%;cursor:pointer;opacity:0;visibility:hidden;transition:opacity 0.2s,visibility 0.2s;}`+
+		`&.visible{opacity:1;visibility:visible;}`)
+
+// NewScrollToTop renders a button that scrolls to the top of the page. The button is
+// shown once the page has been scrolled down by more than the height of the window.
+func NewScrollToTop(label string) Component {
+	return ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		if err = RenderCSSItems(ctx, w, scrollToTopClass); err != nil {
+			return err
+		}
+		if err = writeStrings(w, `<button type="button" class="scroll-to-top `, scrollToTopClass.ID, `" aria-label="`, EscapeString(label), `" data-scroll-to-top>&uarr;</button>`); err != nil {
+			return err
+		}
+		return scrollToTopScript.Render(ctx, w)
+	})
+}
+
+var scrollToTopScript = ComponentScript{
+	Name: `__templ_scrollToTop`,
+	Function: `(function(){` +
+		`function update(){var visible=window.scrollY>window.innerHeight;` +
+		`document.querySelectorAll("[data-scroll-to-top]").forEach(function(b){b.classList.toggle("visible",visible);});}` +
+		`window.addEventListener("scroll",update,{passive:true});update();` +
+		`document.addEventListener("click",function(e){if(!(e.target.closest&&e.target.closest("[data-scroll-to-top]"))){return;}` +
+		`var reduce=window.matchMedia&&window.matchMedia("(prefers-reduced-motion: reduce)").matches;` +
+		`window.scrollTo({top:0,behavior:reduce?"auto":"smooth"});});` +
+		`})();`,
+}
@@ -0,0 +1,34 @@
+package templ_test
+
+import (
+	"bytes"
+	"context"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/google/go-cmp/cmp"
+)
+
+var scrollToTopClassPattern = regexp.MustCompile(`scrollToTop_[0-9a-f]{4}`)
+
+func TestScrollToTop(t *testing.T) {
+	b := new(bytes.Buffer)
+	if err := templ.NewScrollToTop("Back to top <now>").Render(context.Background(), b); err != nil {
+		t.Fatalf("failed to render: %v", err)
+	}
+	output := scrollToTopClassPattern.ReplaceAllString(b.String(), "scrollToTop")
+	style, rest, ok := strings.Cut(output, "</style>")
+	if !ok || !strings.Contains(style, ".scrollToTop.visible{") {
+		t.Errorf("expected the CSS to be rendered, got %q", style)
+	}
+	html, script, _ := strings.Cut(rest, "<script")
+	expected := `<button type="button" class="scroll-to-top scrollToTop" aria-label="Back to top &lt;now&gt;" data-scroll-to-top>&uarr;</button>`
+	if diff := cmp.Diff(expected, html); diff != "" {
+		t.Error(diff)
+	}
+	if !strings.Contains(script, "scrollY") {
+		t.Errorf("expected the script to be rendered, got %q", script)
+	}
+}
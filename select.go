@@ -0,0 +1,69 @@
+package templ
+
+import (
+	"context"
+	"io"
+)
+
+// SelectOption is an option of a select element.
+type SelectOption struct {
+	Value string
+	Label string
+}
+
+// NewSelect renders a labelled select element. The option with a value matching the
+// value parameter is selected.
+func NewSelect(name, label string, options []SelectOption, value string) Component {
+	return selectField{
+		name:    name,
+		label:   label,
+		options: options,
+		value:   value,
+	}
+}
+
+type selectField struct {
+	name         string
+	label        string
+	autocomplete string
+	placeholder  string
+	options      []SelectOption
+	value        string
+}
+
+func (s selectField) Render(ctx context.Context, w io.Writer) (err error) {
+	id := EscapeString(s.name)
+	if err = writeStrings(w,
+		`<label for="`, id, `">`, EscapeString(s.label), `</label>`,
+		`<select id="`, id, `" name="`, id, `"`); err != nil {
+		return err
+	}
+	if s.autocomplete != "" {
+		if err = writeStrings(w, ` autocomplete="`, EscapeString(s.autocomplete), `"`); err != nil {
+			return err
+		}
+	}
+	if _, err = io.WriteString(w, `>`); err != nil {
+		return err
+	}
+	if s.placeholder != "" {
+		if err = writeStrings(w, `<option value="">`, EscapeString(s.placeholder), `</option>`); err != nil {
+			return err
+		}
+	}
+	for _, o := range s.options {
+		if err = writeStrings(w, `<option value="`, EscapeString(o.Value), `"`); err != nil {
+			return err
+		}
+		if o.Value == s.value {
+			if _, err = io.WriteString(w, ` selected`); err != nil {
+				return err
+			}
+		}
+		if err = writeStrings(w, `>`, EscapeString(o.Label), `</option>`); err != nil {
+			return err
+		}
+	}
+	_, err = io.WriteString(w, `</select>`)
+	return err
+}
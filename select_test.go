@@ -0,0 +1,30 @@
+package templ_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSelect(t *testing.T) {
+	options := []templ.SelectOption{
+		{Value: "s", Label: "Small"},
+		{Value: "m", Label: "Medium"},
+		{Value: "l&xl", Label: "Large & Extra large"},
+	}
+	b := new(bytes.Buffer)
+	if err := templ.NewSelect("size", "Size", options, "m").Render(context.Background(), b); err != nil {
+		t.Fatalf("failed to render: %v", err)
+	}
+	expected := `<label for="size">Size</label><select id="size" name="size">` +
+		`<option value="s">Small</option>` +
+		`<option value="m" selected>Medium</option>` +
+		`<option value="l&amp;xl">Large &amp; Extra large</option>` +
+		`</select>`
+	if diff := cmp.Diff(expected, b.String()); diff != "" {
+		t.Error(diff)
+	}
+}
@@ -0,0 +1,41 @@
+package templ
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+)
+
+// NewShareButton renders a button that opens the device's share sheet, using the Web
+// Share API. If the API isn't available, the URL is copied to the clipboard instead.
+func NewShareButton(title, text string, url SafeURL) Component {
+	return ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		data, err := json.Marshal(struct {
+			Title string  `json:"title"`
+			Text  string  `json:"text"`
+			URL   SafeURL `json:"url"`
+		}{
+			Title: title,
+			Text:  text,
+			URL:   URL(string(url)),
+		})
+		if err != nil {
+			return err
+		}
+		if err = writeStrings(w, `<button type="button" class="share-button" data-share="`, EscapeString(string(data)), `">Share</button>`); err != nil {
+			return err
+		}
+		return shareButtonScript.Render(ctx, w)
+	})
+}
+
+var shareButtonScript = ComponentScript{
+	Name: `__templ_shareButton`,
+	Function: `document.addEventListener("click",function(e){` +
+		`var button=e.target.closest&&e.target.closest("[data-share]");if(!button){return;}` +
+		`var data=JSON.parse(button.getAttribute("data-share"));data.url=new URL(data.url,window.location.href).href;` +
+		`if(navigator.share){navigator.share(data).catch(function(){});return;}` +
+		`if(navigator.clipboard){navigator.clipboard.writeText(data.url).then(function(){` +
+		`var label=button.textContent;button.textContent="Link copied";setTimeout(function(){button.textContent=label;},2000);});}` +
+		`});`,
+}
@@ -0,0 +1,46 @@
+package templ_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestShareButton(t *testing.T) {
+	tests := []struct {
+		name     string
+		url      templ.SafeURL
+		expected string
+	}{
+		{
+			name:     "parameters are JSON encoded",
+			url:      "/posts/1?ref=share",
+			expected: `<button type="button" class="share-button" data-share="{&#34;title&#34;:&#34;Hello \u0026 welcome&#34;,&#34;text&#34;:&#34;\u003cb\u003eRead this\u003c/b\u003e&#34;,&#34;url&#34;:&#34;/posts/1?ref=share&#34;}">Share</button>`,
+		},
+		{
+			name:     "unsafe URLs are sanitized",
+			url:      "javascript:alert(1)",
+			expected: `<button type="button" class="share-button" data-share="{&#34;title&#34;:&#34;Hello \u0026 welcome&#34;,&#34;text&#34;:&#34;\u003cb\u003eRead this\u003c/b\u003e&#34;,&#34;url&#34;:&#34;about:invalid#TemplFailedSanitizationURL&#34;}">Share</button>`,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			b := new(bytes.Buffer)
+			if err := templ.NewShareButton("Hello & welcome", "<b>Read this</b>", tt.url).Render(context.Background(), b); err != nil {
+				t.Fatalf("failed to render: %v", err)
+			}
+			html, script, _ := strings.Cut(b.String(), "<script")
+			if diff := cmp.Diff(tt.expected, html); diff != "" {
+				t.Error(diff)
+			}
+			if !strings.Contains(script, "navigator.share") {
+				t.Errorf("expected the script to be rendered, got %q", script)
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package templ
+
+import (
+	"context"
+	"io"
+	"strconv"
+)
+
+// NewSignaturePad renders a canvas that captures a signature drawn with a mouse, pen, or
+// touch, with a button to clear it. When the form is submitted, the signature is written
+// to a hidden input with the given name, as a PNG data URI. The input is empty if nothing
+// has been drawn.
+func NewSignaturePad(name string, width, height int) Component {
+	return ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		if err = writeStrings(w,
+			`<div class="signature-pad" data-signature-pad>`,
+			`<canvas width="`, strconv.Itoa(width), `" height="`, strconv.Itoa(height), `" aria-label="Signature pad" role="img" style="touch-action:none;"></canvas>`,
+			`<button type="button" data-signature-clear>Clear</button>`,
+			`<input type="hidden" name="`, EscapeString(name), `" value="">`,
+			`</div>`); err != nil {
+			return err
+		}
+		return signaturePadScript.Render(ctx, w)
+	})
+}
+
+var signaturePadScript = ComponentScript{
+	Name: `__templ_signaturePad`,
+	Function: `(function(){` +
+		`function pad(e){return e.target.closest&&e.target.closest("[data-signature-pad]");}` +
+		`function point(canvas,e){var rect=canvas.getBoundingClientRect();` +
+		`return{x:(e.clientX-rect.left)*canvas.width/rect.width,y:(e.clientY-rect.top)*canvas.height/rect.height};}` +
+		`document.addEventListener("pointerdown",function(e){var p=pad(e);if(!p||e.target.tagName!=="CANVAS"){return;}` +
+		`var canvas=e.target;var c=canvas.getContext("2d");c.lineWidth=2;c.lineCap="round";c.lineJoin="round";c.strokeStyle="#000";` +
+		`canvas.setPointerCapture(e.pointerId);var last=point(canvas,e);p.setAttribute("data-signed","");` +
+		`function move(e){var next=point(canvas,e);c.beginPath();c.moveTo(last.x,last.y);c.lineTo(next.x,next.y);c.stroke();last=next;}` +
+		`function up(){canvas.removeEventListener("pointermove",move);canvas.removeEventListener("pointerup",up);}` +
+		`canvas.addEventListener("pointermove",move);canvas.addEventListener("pointerup",up);});` +
+		`document.addEventListener("click",function(e){var button=e.target.closest&&e.target.closest("[data-signature-clear]");if(!button){return;}` +
+		`var p=pad(e);var canvas=p.querySelector("canvas");canvas.getContext("2d").clearRect(0,0,canvas.width,canvas.height);` +
+		`p.removeAttribute("data-signed");p.querySelector("input[type=hidden]").value="";});` +
+		`document.addEventListener("submit",function(e){e.target.querySelectorAll("[data-signature-pad]").forEach(function(p){` +
+		`p.querySelector("input[type=hidden]").value=p.hasAttribute("data-signed")?p.querySelector("canvas").toDataURL("image/png"):"";});},true);` +
+		`})();`,
+}
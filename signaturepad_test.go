@@ -0,0 +1,29 @@
+package templ_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSignaturePad(t *testing.T) {
+	b := new(bytes.Buffer)
+	if err := templ.NewSignaturePad("signature", 400, 150).Render(context.Background(), b); err != nil {
+		t.Fatalf("failed to render: %v", err)
+	}
+	html, _, ok := strings.Cut(b.String(), "<script")
+	if !ok {
+		t.Error("expected the signature pad script to be rendered")
+	}
+	expected := `<div class="signature-pad" data-signature-pad>` +
+		`<canvas width="400" height="150" aria-label="Signature pad" role="img" style="touch-action:none;"></canvas>` +
+		`<button type="button" data-signature-clear>Clear</button>` +
+		`<input type="hidden" name="signature" value=""></div>`
+	if diff := cmp.Diff(expected, html); diff != "" {
+		t.Error(diff)
+	}
+}
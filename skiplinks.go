@@ -0,0 +1,38 @@
+package templ
+
+import (
+	"context"
+	"io"
+)
+
+// SkipLink is a link to a section of the page, e.g. the main content or search.
+type SkipLink struct {
+	Label string
+	// Target is the id of the element to skip to.
+	Target string
+}
+
+var skipLinksClass = newComponentCSSRules("skipLinks",
+	`& a{position:absolute;left:-10000px;top:auto;width:1px;height:1px;overflow:hidden;}`+
+		`& a:focus{position:fixed;left:1em;top:1em;z-index:1000;width:auto;height:auto;padding:0.5em 1em;background:#fff;color:#000;outline:2px solid #2563eb;}`)
+
+// NewA11ySkipLinks renders a group of links that allow keyboard users to skip to sections
+// of the page. The links are visually hidden until focused, so should be the first
+// focusable elements on the page.
+func NewA11ySkipLinks(links []SkipLink) Component {
+	return ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		if err = RenderCSSItems(ctx, w, skipLinksClass); err != nil {
+			return err
+		}
+		if err = writeStrings(w, `<nav class="skip-links `, skipLinksClass.ID, `" aria-label="Skip links">`); err != nil {
+			return err
+		}
+		for _, l := range links {
+			if err = writeStrings(w, `<a href="#`, EscapeString(l.Target), `">`, EscapeString(l.Label), `</a>`); err != nil {
+				return err
+			}
+		}
+		_, err = io.WriteString(w, `</nav>`)
+		return err
+	})
+}
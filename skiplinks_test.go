@@ -0,0 +1,39 @@
+package templ_test
+
+import (
+	"bytes"
+	"context"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/google/go-cmp/cmp"
+)
+
+var skipLinksClassPattern = regexp.MustCompile(`skipLinks_[0-9a-f]{4}`)
+
+func TestA11ySkipLinks(t *testing.T) {
+	links := []templ.SkipLink{
+		{Label: "Skip to main content", Target: "main"},
+		{Label: "Skip to search", Target: "search"},
+		{Label: "Skip to footer & contact", Target: `footer"`},
+	}
+	b := new(bytes.Buffer)
+	if err := templ.NewA11ySkipLinks(links).Render(context.Background(), b); err != nil {
+		t.Fatalf("failed to render: %v", err)
+	}
+	output := skipLinksClassPattern.ReplaceAllString(b.String(), "skipLinks")
+	style, html, ok := strings.Cut(output, "</style>")
+	if !ok || !strings.Contains(style, ".skipLinks a:focus{") {
+		t.Errorf("expected the CSS to be rendered, got %q", style)
+	}
+	expected := `<nav class="skip-links skipLinks" aria-label="Skip links">` +
+		`<a href="#main">Skip to main content</a>` +
+		`<a href="#search">Skip to search</a>` +
+		`<a href="#footer&#34;">Skip to footer &amp; contact</a>` +
+		`</nav>`
+	if diff := cmp.Diff(expected, html); diff != "" {
+		t.Error(diff)
+	}
+}
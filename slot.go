@@ -0,0 +1,46 @@
+package templ
+
+import "context"
+
+type slotContextKeyType int
+
+const slotContextKey = slotContextKeyType(0)
+
+// WithSlot sets the component rendered in the named slot. Slots allow a component
+// to render content in multiple positions, e.g. a header, body, and footer.
+//
+// Slots are independent of the children set by WithChildren.
+func WithSlot(ctx context.Context, name string, c Component) context.Context {
+	existing, _ := ctx.Value(slotContextKey).(map[string]Component)
+	slots := make(map[string]Component, len(existing)+1)
+	for k, v := range existing {
+		slots[k] = v
+	}
+	slots[name] = c
+	return context.WithValue(ctx, slotContextKey, slots)
+}
+
+// GetSlot returns the component in the named slot, or the NopComponent if the slot
+// has not been set.
+func GetSlot(ctx context.Context, name string) Component {
+	slots, _ := ctx.Value(slotContextKey).(map[string]Component)
+	if c, ok := slots[name]; ok && c != nil {
+		return c
+	}
+	return NopComponent
+}
+
+// ClearSlot removes the component from the named slot.
+func ClearSlot(ctx context.Context, name string) context.Context {
+	existing, _ := ctx.Value(slotContextKey).(map[string]Component)
+	if _, ok := existing[name]; !ok {
+		return ctx
+	}
+	slots := make(map[string]Component, len(existing))
+	for k, v := range existing {
+		if k != name {
+			slots[k] = v
+		}
+	}
+	return context.WithValue(ctx, slotContextKey, slots)
+}
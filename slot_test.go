@@ -0,0 +1,99 @@
+package templ_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSlots(t *testing.T) {
+	layout := templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		if _, err = io.WriteString(w, "<header>"); err != nil {
+			return err
+		}
+		if err = templ.GetSlot(ctx, "header").Render(ctx, w); err != nil {
+			return err
+		}
+		if _, err = io.WriteString(w, "</header><main>"); err != nil {
+			return err
+		}
+		if err = templ.GetChildren(ctx).Render(ctx, w); err != nil {
+			return err
+		}
+		if _, err = io.WriteString(w, "</main><footer>"); err != nil {
+			return err
+		}
+		if err = templ.GetSlot(ctx, "footer").Render(ctx, w); err != nil {
+			return err
+		}
+		_, err = io.WriteString(w, "</footer>")
+		return err
+	})
+
+	tests := []struct {
+		name     string
+		ctx      func() context.Context
+		expected string
+	}{
+		{
+			name: "slots that are not set render nothing",
+			ctx: func() context.Context {
+				return context.Background()
+			},
+			expected: `<header></header><main></main><footer></footer>`,
+		},
+		{
+			name: "slots are rendered in position",
+			ctx: func() context.Context {
+				ctx := templ.WithSlot(context.Background(), "header", templ.Raw("Title"))
+				return templ.WithSlot(ctx, "footer", templ.Raw("Copyright"))
+			},
+			expected: `<header>Title</header><main></main><footer>Copyright</footer>`,
+		},
+		{
+			name: "slots are independent of children",
+			ctx: func() context.Context {
+				ctx := templ.WithSlot(context.Background(), "header", templ.Raw("Title"))
+				return templ.WithChildren(ctx, templ.Raw("Body"))
+			},
+			expected: `<header>Title</header><main>Body</main><footer></footer>`,
+		},
+		{
+			name: "cleared slots render nothing",
+			ctx: func() context.Context {
+				ctx := templ.WithSlot(context.Background(), "header", templ.Raw("Title"))
+				ctx = templ.WithSlot(ctx, "footer", templ.Raw("Copyright"))
+				return templ.ClearSlot(ctx, "header")
+			},
+			expected: `<header></header><main></main><footer>Copyright</footer>`,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			b := new(bytes.Buffer)
+			if err := layout.Render(tt.ctx(), b); err != nil {
+				t.Fatalf("failed to render: %v", err)
+			}
+			if diff := cmp.Diff(tt.expected, b.String()); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+	t.Run("setting a slot does not modify the parent context", func(t *testing.T) {
+		parent := templ.WithSlot(context.Background(), "header", templ.Raw("Parent"))
+		_ = templ.WithSlot(parent, "header", templ.Raw("Child"))
+		_ = templ.ClearSlot(parent, "header")
+		b := new(bytes.Buffer)
+		if err := templ.GetSlot(parent, "header").Render(parent, b); err != nil {
+			t.Fatalf("failed to render: %v", err)
+		}
+		if diff := cmp.Diff("Parent", b.String()); diff != "" {
+			t.Error(diff)
+		}
+	})
+}
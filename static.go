@@ -0,0 +1,64 @@
+package templ
+
+import (
+	"bytes"
+	"context"
+	"embed"
+	"io"
+	"io/fs"
+)
+
+// StaticComponent returns a component that writes the content, without escaping.
+// The content must not be modified after the component is created.
+func StaticComponent(content []byte) Component {
+	return staticComponent(content)
+}
+
+type staticComponent []byte
+
+func (sc staticComponent) Render(ctx context.Context, w io.Writer) (err error) {
+	_, err = w.Write(sc)
+	return err
+}
+
+// PreRender renders the component once, and returns a StaticComponent containing the
+// output. It's intended for components that always produce the same output, e.g.
+// landing pages rendered at server startup.
+func PreRender(ctx context.Context, c Component) (Component, error) {
+	var b bytes.Buffer
+	if err := c.Render(ctx, &b); err != nil {
+		return nil, err
+	}
+	return StaticComponent(b.Bytes()), nil
+}
+
+// FromEmbedFS returns a StaticComponent containing the file at path in fsys, e.g. HTML
+// rendered at build time and embedded with go:embed.
+func FromEmbedFS(fsys embed.FS, path string) (Component, error) {
+	content, err := fsys.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return StaticComponent(content), nil
+}
+
+// AllFromEmbedFS returns a StaticComponent for each file in dir, and its subdirectories.
+// The map is keyed by the path of each file within fsys, e.g. "pages/about/index.html".
+func AllFromEmbedFS(fsys embed.FS, dir string) (map[string]Component, error) {
+	components := map[string]Component{}
+	err := fs.WalkDir(fsys, dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		c, err := FromEmbedFS(fsys, path)
+		if err != nil {
+			return err
+		}
+		components[path] = c
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return components, nil
+}
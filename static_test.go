@@ -0,0 +1,121 @@
+package templ_test
+
+import (
+	"bytes"
+	"context"
+	"embed"
+	"errors"
+	"io"
+	"io/fs"
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestStaticComponent(t *testing.T) {
+	b := new(bytes.Buffer)
+	if err := templ.StaticComponent([]byte("<h1>Static</h1>")).Render(context.Background(), b); err != nil {
+		t.Fatalf("failed to render: %v", err)
+	}
+	if diff := cmp.Diff("<h1>Static</h1>", b.String()); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func BenchmarkStaticComponent(b *testing.B) {
+	b.ReportAllocs()
+	c := templ.StaticComponent([]byte("<h1>Static</h1>"))
+	ctx := context.Background()
+	for i := 0; i < b.N; i++ {
+		if err := c.Render(ctx, io.Discard); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestPreRender(t *testing.T) {
+	t.Run("the component is only rendered once", func(t *testing.T) {
+		var renders int
+		c := templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+			renders++
+			_, err := io.WriteString(w, "<p>Hello</p>")
+			return err
+		})
+		static, err := templ.PreRender(context.Background(), c)
+		if err != nil {
+			t.Fatalf("failed to pre-render: %v", err)
+		}
+		for i := 0; i < 2; i++ {
+			b := new(bytes.Buffer)
+			if err := static.Render(context.Background(), b); err != nil {
+				t.Fatalf("failed to render: %v", err)
+			}
+			if diff := cmp.Diff("<p>Hello</p>", b.String()); diff != "" {
+				t.Error(diff)
+			}
+		}
+		if renders != 1 {
+			t.Errorf("expected 1 render, got %d", renders)
+		}
+	})
+	t.Run("render errors are returned", func(t *testing.T) {
+		expected := errors.New("render error")
+		if _, err := templ.PreRender(context.Background(), templ.Raw("", expected)); !errors.Is(err, expected) {
+			t.Errorf("expected %v, got %v", expected, err)
+		}
+	})
+}
+
+//go:embed testdata/static
+var staticFS embed.FS
+
+func TestFromEmbedFS(t *testing.T) {
+	t.Run("files are rendered as is", func(t *testing.T) {
+		c, err := templ.FromEmbedFS(staticFS, "testdata/static/index.html")
+		if err != nil {
+			t.Fatalf("failed to read file: %v", err)
+		}
+		b := new(bytes.Buffer)
+		if err := c.Render(context.Background(), b); err != nil {
+			t.Fatalf("failed to render: %v", err)
+		}
+		if diff := cmp.Diff("<h1>Home</h1>\n", b.String()); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("missing files return an error", func(t *testing.T) {
+		if _, err := templ.FromEmbedFS(staticFS, "testdata/static/missing.html"); !errors.Is(err, fs.ErrNotExist) {
+			t.Errorf("expected %v, got %v", fs.ErrNotExist, err)
+		}
+	})
+}
+
+func TestAllFromEmbedFS(t *testing.T) {
+	t.Run("all files in the directory are returned", func(t *testing.T) {
+		components, err := templ.AllFromEmbedFS(staticFS, "testdata/static")
+		if err != nil {
+			t.Fatalf("failed to read directory: %v", err)
+		}
+		expected := map[string]string{
+			"testdata/static/index.html":       "<h1>Home</h1>\n",
+			"testdata/static/about/index.html": "<h1>About</h1>\n",
+		}
+		actual := map[string]string{}
+		for path, c := range components {
+			b := new(bytes.Buffer)
+			if err := c.Render(context.Background(), b); err != nil {
+				t.Fatalf("failed to render: %v", err)
+			}
+			actual[path] = b.String()
+		}
+		if diff := cmp.Diff(expected, actual); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("missing directories return an error", func(t *testing.T) {
+		if _, err := templ.AllFromEmbedFS(staticFS, "testdata/missing"); !errors.Is(err, fs.ErrNotExist) {
+			t.Errorf("expected %v, got %v", fs.ErrNotExist, err)
+		}
+	})
+}
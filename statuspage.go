@@ -0,0 +1,113 @@
+package templ
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+// StatusLevel is the health of a service.
+type StatusLevel int
+
+const (
+	// StatusOK means the service is operating normally.
+	StatusOK StatusLevel = iota
+	// StatusDegraded means the service is working, with reduced performance or functionality.
+	StatusDegraded
+	// StatusDown means the service is not working.
+	StatusDown
+)
+
+// String returns the description of the status level.
+func (s StatusLevel) String() string {
+	switch s {
+	case StatusOK:
+		return "Operational"
+	case StatusDegraded:
+		return "Degraded"
+	case StatusDown:
+		return "Down"
+	}
+	return "Unknown"
+}
+
+func (s StatusLevel) className() string {
+	switch s {
+	case StatusOK:
+		return "status-ok"
+	case StatusDegraded:
+		return "status-degraded"
+	case StatusDown:
+		return "status-down"
+	}
+	return "status-unknown"
+}
+
+// ServiceStatus is the status of a service displayed on a status page.
+type ServiceStatus struct {
+	Name    string
+	Status  StatusLevel
+	Message string
+	Updated time.Time
+}
+
+var statusPageClass = newComponentCSSRules("statusPage",
+	`&{list-style:none;padding:0;display:grid;grid-template-columns:repeat(auto-fill,minmax(16em,1fr));gap:1em;}`+
+		`& .service-status{border:1px solid #e5e7eb;border-radius:0.5em;padding:1em;}`+
+		`& .status-indicator{display:inline-block;width:0.75em;height:0.75em;border-radius:50%;margin-right:0.5em;background-color:#9ca3af;}`+
+		`& .status-ok .status-indicator{background-color:#16a34a;}`+
+		`& .status-degraded .status-indicator{background-color:#eab308;}`+
+		`& .status-down .status-indicator{background-color:#dc2626;}`)
+
+// NewStatusPage renders a summary of the overall status, followed by a grid of services,
+// each with a colored indicator. The status of each service is also included as text, so
+// it's not conveyed by color alone.
+func NewStatusPage(services []ServiceStatus) Component {
+	overall := StatusOK
+	for _, s := range services {
+		if s.Status > overall {
+			overall = s.Status
+		}
+	}
+	return ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		if err = RenderCSSItems(ctx, w, statusPageClass); err != nil {
+			return err
+		}
+		summary := "All systems operational"
+		switch overall {
+		case StatusDegraded:
+			summary = "Some systems degraded"
+		case StatusDown:
+			summary = "Some systems down"
+		}
+		if err = writeStrings(w,
+			`<section class="status-page"><p role="status" class="`, overall.className(), `">`, summary, `</p>`,
+			`<ul class="`, statusPageClass.ID, `">`); err != nil {
+			return err
+		}
+		for _, s := range services {
+			if err = writeStrings(w,
+				`<li class="service-status `, s.Status.className(), `">`,
+				`<h3><span class="status-indicator" aria-hidden="true"></span>`, EscapeString(s.Name), `</h3>`,
+				`<p class="status-level">`, s.Status.String(), `</p>`); err != nil {
+				return err
+			}
+			if s.Message != "" {
+				if err = writeStrings(w, `<p class="status-message">`, EscapeString(s.Message), `</p>`); err != nil {
+					return err
+				}
+			}
+			if !s.Updated.IsZero() {
+				if err = writeStrings(w,
+					`<p class="status-updated">Updated <time datetime="`, s.Updated.Format(time.RFC3339), `">`, s.Updated.Format("2 Jan 2006 15:04 MST"), `</time></p>`); err != nil {
+					return err
+				}
+			}
+			if _, err = io.WriteString(w, `</li>`); err != nil {
+				return err
+			}
+		}
+		_, err = io.WriteString(w, `</ul></section>`)
+		return err
+	})
+}
@@ -0,0 +1,67 @@
+package templ_test
+
+import (
+	"bytes"
+	"context"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/a-h/templ"
+	"github.com/google/go-cmp/cmp"
+)
+
+var statusPageClassPattern = regexp.MustCompile(`statusPage_[0-9a-f]{4}`)
+
+func TestStatusPage(t *testing.T) {
+	updated := time.Date(2024, time.March, 1, 9, 30, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		services []templ.ServiceStatus
+		expected string
+	}{
+		{
+			name:     "no services",
+			expected: `<section class="status-page"><p role="status" class="status-ok">All systems operational</p><ul class="statusPage"></ul></section>`,
+		},
+		{
+			name: "the overall status is the worst service status",
+			services: []templ.ServiceStatus{
+				{Name: "API", Status: templ.StatusOK},
+				{Name: "<Database>", Status: templ.StatusDegraded, Message: "Slow queries", Updated: updated},
+			},
+			expected: `<section class="status-page"><p role="status" class="status-degraded">Some systems degraded</p><ul class="statusPage">` +
+				`<li class="service-status status-ok"><h3><span class="status-indicator" aria-hidden="true"></span>API</h3><p class="status-level">Operational</p></li>` +
+				`<li class="service-status status-degraded"><h3><span class="status-indicator" aria-hidden="true"></span>&lt;Database&gt;</h3><p class="status-level">Degraded</p>` +
+				`<p class="status-message">Slow queries</p><p class="status-updated">Updated <time datetime="2024-03-01T09:30:00Z">1 Mar 2024 09:30 UTC</time></p></li>` +
+				`</ul></section>`,
+		},
+		{
+			name: "services that are down",
+			services: []templ.ServiceStatus{
+				{Name: "API", Status: templ.StatusDown},
+			},
+			expected: `<section class="status-page"><p role="status" class="status-down">Some systems down</p><ul class="statusPage">` +
+				`<li class="service-status status-down"><h3><span class="status-indicator" aria-hidden="true"></span>API</h3><p class="status-level">Down</p></li>` +
+				`</ul></section>`,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			b := new(bytes.Buffer)
+			if err := templ.NewStatusPage(tt.services).Render(context.Background(), b); err != nil {
+				t.Fatalf("failed to render: %v", err)
+			}
+			output := statusPageClassPattern.ReplaceAllString(b.String(), "statusPage")
+			style, html, ok := strings.Cut(output, "</style>")
+			if !ok || !strings.Contains(style, ".statusPage .status-down .status-indicator") {
+				t.Errorf("expected the CSS to be rendered, got %q", style)
+			}
+			if diff := cmp.Diff(tt.expected, html); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
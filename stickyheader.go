@@ -0,0 +1,44 @@
+package templ
+
+import (
+	"context"
+	"io"
+)
+
+var stickyHeaderClass = newComponentCSSRules("stickyHeader",
+	`&{position:sticky;top:0;z-index:100;transition:transform 0.2s;}`+
+		`&.hidden{transform:translateY(-100%);}`)
+
+// NewStickyHeader renders the body in a header that sticks to the top of the page. The
+// header is hidden when the user scrolls down, and shown again when they scroll up.
+func NewStickyHeader(body Component) Component {
+	return ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		if err = RenderCSSItems(ctx, w, stickyHeaderClass); err != nil {
+			return err
+		}
+		if err = writeStrings(w, `<div data-sticky-header-sentinel></div><header class="sticky-header `, stickyHeaderClass.ID, `" data-sticky-header>`); err != nil {
+			return err
+		}
+		if err = body.Render(ctx, w); err != nil {
+			return err
+		}
+		if _, err = io.WriteString(w, `</header>`); err != nil {
+			return err
+		}
+		return stickyHeaderScript.Render(ctx, w)
+	})
+}
+
+var stickyHeaderScript = ComponentScript{
+	Name: `__templ_stickyHeader`,
+	// The sentinel is above the header, so while it's visible, the page is at the top and
+	// the header is always shown.
+	Function: `(function(){` +
+		`var header=document.querySelector("[data-sticky-header]");var sentinel=document.querySelector("[data-sticky-header-sentinel]");` +
+		`if(!header||!sentinel||!window.IntersectionObserver){return;}` +
+		`var atTop=true;var lastY=window.scrollY;` +
+		`new IntersectionObserver(function(entries){atTop=entries[0].isIntersecting;if(atTop){header.classList.remove("hidden");}}).observe(sentinel);` +
+		`window.addEventListener("scroll",function(){var y=window.scrollY;` +
+		`if(!atTop){header.classList.toggle("hidden",y>lastY);}lastY=y;},{passive:true});` +
+		`})();`,
+}
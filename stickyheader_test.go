@@ -0,0 +1,34 @@
+package templ_test
+
+import (
+	"bytes"
+	"context"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/google/go-cmp/cmp"
+)
+
+var stickyHeaderClassPattern = regexp.MustCompile(`stickyHeader_[0-9a-f]{4}`)
+
+func TestStickyHeader(t *testing.T) {
+	b := new(bytes.Buffer)
+	if err := templ.NewStickyHeader(templ.Raw(`<nav>Menu</nav>`)).Render(context.Background(), b); err != nil {
+		t.Fatalf("failed to render: %v", err)
+	}
+	output := stickyHeaderClassPattern.ReplaceAllString(b.String(), "stickyHeader")
+	style, rest, ok := strings.Cut(output, "</style>")
+	if !ok || !strings.Contains(style, ".stickyHeader.hidden{") {
+		t.Errorf("expected the CSS to be rendered, got %q", style)
+	}
+	html, script, _ := strings.Cut(rest, "<script")
+	expected := `<div data-sticky-header-sentinel></div><header class="sticky-header stickyHeader" data-sticky-header><nav>Menu</nav></header>`
+	if diff := cmp.Diff(expected, html); diff != "" {
+		t.Error(diff)
+	}
+	if !strings.Contains(script, "IntersectionObserver") {
+		t.Errorf("expected the script to be rendered, got %q", script)
+	}
+}
@@ -0,0 +1,142 @@
+package templ
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/a-h/templ/safehtml"
+)
+
+// SanitizeCSSURL returns a CSS url() value, e.g. url("/img.png"). The rawURL can be a URL,
+// or a CSS url() value, with or without quotes.
+//
+// The URL is sanitized in the same way as URL. If the rawURL can't be parsed, or uses an
+// unsafe scheme, e.g. javascript:, the FailedSanitizationURL is used.
+func SanitizeCSSURL(rawURL string) SafeCSS {
+	u, ok := sanitizeCSSURL(rawURL)
+	if !ok {
+		return cssURL(FailedSanitizationURL)
+	}
+	return u
+}
+
+// sanitizeCSSURL returns the sanitized CSS url() value, and false if the rawURL could not
+// be parsed, or the URL failed sanitization.
+func sanitizeCSSURL(rawURL string) (SafeCSS, bool) {
+	s := strings.TrimSpace(rawURL)
+	if strings.HasPrefix(s, "url(") {
+		if !strings.HasSuffix(s, ")") {
+			return "", false
+		}
+		s = strings.TrimSpace(s[len("url(") : len(s)-1])
+		if len(s) >= 2 && (s[0] == '"' || s[0] == '\'') {
+			quote := s[0]
+			if s[len(s)-1] != quote {
+				return "", false
+			}
+			s = s[1 : len(s)-1]
+			if strings.IndexByte(s, quote) >= 0 {
+				return "", false
+			}
+		} else if strings.ContainsAny(s, "\"'() \t") {
+			// Unquoted URLs can't contain quotes, parentheses, or whitespace.
+			return "", false
+		}
+	}
+	// Backslashes are CSS escapes, which could be used to hide the scheme.
+	if s == "" || strings.ContainsAny(s, "\\\n\r\f\x00") {
+		return "", false
+	}
+	u := URL(s)
+	if u == FailedSanitizationURL {
+		return "", false
+	}
+	return cssURL(u), true
+}
+
+var cssURLReplacer = strings.NewReplacer(`"`, `\"`, `<`, `\3c `, `>`, `\3e `)
+
+func cssURL(u SafeURL) SafeCSS {
+	return SafeCSS(`url("` + cssURLReplacer.Replace(string(u)) + `")`)
+}
+
+// StyleMap is a map of CSS properties to values, used to build a style attribute.
+type StyleMap map[string]string
+
+// RenderStyleAttr sanitizes each property and value with SanitizeCSS, and concatenates
+// the results in property order, e.g. "color:red;width:10px;".
+func RenderStyleAttr(attrs StyleMap) SafeCSS {
+	properties := make([]string, 0, len(attrs))
+	for p := range attrs {
+		properties = append(properties, p)
+	}
+	sort.Strings(properties)
+	var sb strings.Builder
+	for _, p := range properties {
+		sb.WriteString(string(SanitizeCSS(p, attrs[p])))
+	}
+	return SafeCSS(sb.String())
+}
+
+// StyleAttrString returns the result of RenderStyleAttr as a string.
+func StyleAttrString(attrs StyleMap) string {
+	return string(RenderStyleAttr(attrs))
+}
+
+// SafeCSSVar returns a var() reference to the CSS custom property, e.g. var(--main-color).
+// The leading -- of the name is optional. If the name is not a valid custom property name,
+// var(--templ-fallback) is returned.
+func SafeCSSVar(name string) SafeCSS {
+	if !strings.HasPrefix(name, "--") {
+		name = "--" + name
+	}
+	if !safehtml.CustomPropertyNamePattern.MatchString(name) {
+		return SafeCSS(safehtml.VarFallbackValue)
+	}
+	return SafeCSS("var(" + name + ")")
+}
+
+// VendorPrefixTable maps CSS properties to the vendor prefixes used by SanitizeCSSWithPrefixes.
+// It can be extended by adding entries before rendering.
+var VendorPrefixTable = map[string][]string{
+	"appearance":           {"-webkit-", "-moz-"},
+	"backdrop-filter":      {"-webkit-"},
+	"background-clip":      {"-webkit-"},
+	"box-decoration-break": {"-webkit-"},
+	"hyphens":              {"-webkit-"},
+	"mask":                 {"-webkit-"},
+	"mask-image":           {"-webkit-"},
+	"text-size-adjust":     {"-webkit-", "-moz-"},
+	"user-select":          {"-webkit-", "-moz-"},
+}
+
+// SanitizeCSSWithPrefixes sanitizes the property and value with SanitizeCSS, and returns the
+// vendor prefixed properties listed in VendorPrefixTable, followed by the property itself, so
+// that the standard property takes precedence in browsers that support it.
+func SanitizeCSSWithPrefixes(property, value string) []SafeCSS {
+	prefixes := VendorPrefixTable[strings.ToLower(property)]
+	css := make([]SafeCSS, 0, len(prefixes)+1)
+	for _, prefix := range prefixes {
+		css = append(css, SanitizeCSS(prefix+property, value))
+	}
+	return append(css, SanitizeCSS(property, value))
+}
+
+// CombineCSS concatenates the CSS. The parts have already been sanitized, so the result
+// isn't sanitized again.
+func CombineCSS(parts ...SafeCSS) SafeCSS {
+	return CombineCSSWithSep("", parts...)
+}
+
+// CombineCSSWithSep concatenates the CSS, with sep between each part, e.g. a newline to make
+// the output readable.
+func CombineCSSWithSep(sep string, parts ...SafeCSS) SafeCSS {
+	var sb strings.Builder
+	for i, p := range parts {
+		if i > 0 {
+			sb.WriteString(sep)
+		}
+		sb.WriteString(string(p))
+	}
+	return SafeCSS(sb.String())
+}
@@ -0,0 +1,314 @@
+package templ_test
+
+import (
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRenderStyleAttr(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    templ.StyleMap
+		expected templ.SafeCSS
+	}{
+		{
+			name:     "empty maps render nothing",
+			input:    templ.StyleMap{},
+			expected: ``,
+		},
+		{
+			name:     "properties are rendered in order",
+			input:    templ.StyleMap{"width": "10px", "color": "red"},
+			expected: `color:red;width:10px;`,
+		},
+		{
+			name:     "unsafe values are sanitized",
+			input:    templ.StyleMap{"background-image": "url(javascript:alert(1))"},
+			expected: `background-image:zTemplUnsafeCSSPropertyValue;`,
+		},
+		{
+			name:     "unsafe properties are sanitized",
+			input:    templ.StyleMap{"color:red;}</style>": "blue"},
+			expected: `zTemplUnsafeCSSPropertyName:zTemplUnsafeCSSPropertyValue;`,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if diff := cmp.Diff(tt.expected, templ.RenderStyleAttr(tt.input)); diff != "" {
+				t.Error(diff)
+			}
+			if diff := cmp.Diff(string(tt.expected), templ.StyleAttrString(tt.input)); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestSanitizeCSSURL(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected templ.SafeCSS
+	}{
+		{
+			name:     "URLs are wrapped in url()",
+			input:    "/img.png",
+			expected: `url("/img.png")`,
+		},
+		{
+			name:     "double quoted url() values are supported",
+			input:    `url("https://example.com/img.png")`,
+			expected: `url("https://example.com/img.png")`,
+		},
+		{
+			name:     "single quoted url() values are supported",
+			input:    `url('/img.png?name="a"')`,
+			expected: `url("/img.png?name=\"a\"")`,
+		},
+		{
+			name:     "unquoted url() values are supported",
+			input:    ` url( /img.png ) `,
+			expected: `url("/img.png")`,
+		},
+		{
+			name:     "angle brackets are escaped",
+			input:    `url("/</style>.png")`,
+			expected: `url("/\3c /style\3e .png")`,
+		},
+		{
+			name:     "unsafe schemes fail sanitization",
+			input:    `url("javascript:alert(1)")`,
+			expected: `url("about:invalid#TemplFailedSanitizationURL")`,
+		},
+		{
+			name:     "unsafe schemes without url() fail sanitization",
+			input:    `JavaScript:alert(1)`,
+			expected: `url("about:invalid#TemplFailedSanitizationURL")`,
+		},
+		{
+			name:     "CSS escapes fail sanitization",
+			input:    `url("java\73 cript:alert(1)")`,
+			expected: `url("about:invalid#TemplFailedSanitizationURL")`,
+		},
+		{
+			name:     "unterminated url() values fail sanitization",
+			input:    `url("/img.png"`,
+			expected: `url("about:invalid#TemplFailedSanitizationURL")`,
+		},
+		{
+			name:     "mismatched quotes fail sanitization",
+			input:    `url("/img.png')`,
+			expected: `url("about:invalid#TemplFailedSanitizationURL")`,
+		},
+		{
+			name:     "multiple values fail sanitization",
+			input:    `url("/a.png"), url("/b.png")`,
+			expected: `url("about:invalid#TemplFailedSanitizationURL")`,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if diff := cmp.Diff(tt.expected, templ.SanitizeCSSURL(tt.input)); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestSanitizeCSSWithURL(t *testing.T) {
+	tests := []struct {
+		name     string
+		property string
+		value    string
+		expected templ.SafeCSS
+	}{
+		{
+			name:     "unquoted url() values are output unchanged",
+			property: "background-image",
+			value:    `url(/a.png)`,
+			expected: `background-image:url(/a.png);`,
+		},
+		{
+			name:     "quoted url() values are output unchanged",
+			property: "background-image",
+			value:    `url('/a.png')`,
+			expected: `background-image:url('/a.png');`,
+		},
+		{
+			name:     "multiple url() values are output unchanged",
+			property: "background-image",
+			value:    `url("/a.png"), url("/b.png")`,
+			expected: `background-image:url("/a.png"), url("/b.png");`,
+		},
+		{
+			name:     "unsafe url() values are not used",
+			property: "background-image",
+			value:    `url(javascript:alert(1))`,
+			expected: `background-image:zTemplUnsafeCSSPropertyValue;`,
+		},
+		{
+			name:     "url() values containing CSS escapes are not used",
+			property: "background-image",
+			value:    `url("/a\2e png")`,
+			expected: `background-image:zTemplUnsafeCSSPropertyValue;`,
+		},
+		{
+			name:     "url() values are not used for properties that don't take URLs",
+			property: "color",
+			value:    `url(/a.png)`,
+			expected: `color:zTemplUnsafeCSSPropertyValue;`,
+		},
+		{
+			name:     "url() values are not used for properties without a URL sanitizer",
+			property: "cursor",
+			value:    `url(/pointer.png)`,
+			expected: `cursor:zTemplUnsafeCSSPropertyValue;`,
+		},
+		{
+			name:     "unsafe properties are not used",
+			property: "}body{",
+			value:    `url(/a.png)`,
+			expected: `zTemplUnsafeCSSPropertyName:zTemplUnsafeCSSPropertyValue;`,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if diff := cmp.Diff(tt.expected, templ.SanitizeCSS(tt.property, tt.value)); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestSafeCSSVar(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected templ.SafeCSS
+	}{
+		{
+			name:     "names with a prefix",
+			input:    "--main-color",
+			expected: `var(--main-color)`,
+		},
+		{
+			name:     "names without a prefix",
+			input:    "main_color2",
+			expected: `var(--main_color2)`,
+		},
+		{
+			name:     "invalid names use the fallback",
+			input:    "main-color);background:url(x",
+			expected: `var(--templ-fallback)`,
+		},
+		{
+			name:     "names cannot start with a digit",
+			input:    "--1st",
+			expected: `var(--templ-fallback)`,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if diff := cmp.Diff(tt.expected, templ.SafeCSSVar(tt.input)); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestSanitizeCSSWithPrefixes(t *testing.T) {
+	tests := []struct {
+		name     string
+		property string
+		value    string
+		expected []templ.SafeCSS
+	}{
+		{
+			name:     "properties without prefixes",
+			property: "color",
+			value:    "red",
+			expected: []templ.SafeCSS{`color:red;`},
+		},
+		{
+			name:     "prefixed properties come first",
+			property: "user-select",
+			value:    "none",
+			expected: []templ.SafeCSS{`-webkit-user-select:none;`, `-moz-user-select:none;`, `user-select:none;`},
+		},
+		{
+			name:     "property names are not case sensitive",
+			property: "Backdrop-Filter",
+			value:    "blur(2px)",
+			expected: []templ.SafeCSS{`-webkit-backdrop-filter:zTemplUnsafeCSSPropertyValue;`, `backdrop-filter:zTemplUnsafeCSSPropertyValue;`},
+		},
+		{
+			name:     "values are sanitized",
+			property: "appearance",
+			value:    "none;}</style>",
+			expected: []templ.SafeCSS{
+				`-webkit-appearance:zTemplUnsafeCSSPropertyValue;`,
+				`-moz-appearance:zTemplUnsafeCSSPropertyValue;`,
+				`appearance:zTemplUnsafeCSSPropertyValue;`,
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if diff := cmp.Diff(tt.expected, templ.SanitizeCSSWithPrefixes(tt.property, tt.value)); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+	t.Run("the table can be extended", func(t *testing.T) {
+		templ.VendorPrefixTable["tab-size"] = []string{"-moz-"}
+		defer delete(templ.VendorPrefixTable, "tab-size")
+		expected := []templ.SafeCSS{`-moz-tab-size:4;`, `tab-size:4;`}
+		if diff := cmp.Diff(expected, templ.SanitizeCSSWithPrefixes("tab-size", "4")); diff != "" {
+			t.Error(diff)
+		}
+	})
+}
+
+func TestCombineCSS(t *testing.T) {
+	tests := []struct {
+		name     string
+		actual   templ.SafeCSS
+		expected templ.SafeCSS
+	}{
+		{
+			name:     "no parts",
+			actual:   templ.CombineCSS(),
+			expected: ``,
+		},
+		{
+			name:     "parts are concatenated",
+			actual:   templ.CombineCSS(templ.SanitizeCSS("color", "red"), templ.SanitizeCSS("width", "10px")),
+			expected: `color:red;width:10px;`,
+		},
+		{
+			name:     "parts are separated",
+			actual:   templ.CombineCSSWithSep("\n", templ.SanitizeCSS("color", "red"), templ.SanitizeCSS("width", "10px")),
+			expected: "color:red;\nwidth:10px;",
+		},
+		{
+			name:     "a single part is not separated",
+			actual:   templ.CombineCSSWithSep(" ", templ.SanitizeCSS("color", "red")),
+			expected: `color:red;`,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if diff := cmp.Diff(tt.expected, tt.actual); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package templ
+
+import (
+	"context"
+	"io"
+)
+
+// NewTagInput renders a list of tags, and a text input used to add tags. Tags are added
+// by pressing Enter or typing a comma, and removed with their remove button, or by
+// pressing Backspace in the empty text input.
+//
+// Each tag is submitted in a hidden input with the given name.
+func NewTagInput(name string, values []string) Component {
+	return ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		n := EscapeString(name)
+		if err = writeStrings(w, `<ul class="tag-input" data-tag-input data-name="`, n, `">`); err != nil {
+			return err
+		}
+		for _, v := range values {
+			value := EscapeString(v)
+			if err = writeStrings(w,
+				`<li class="tag"><span>`, value, `</span>`,
+				`<input type="hidden" name="`, n, `" value="`, value, `">`,
+				`<button type="button" aria-label="Remove `, value, `" data-tag-remove>×</button></li>`); err != nil {
+				return err
+			}
+		}
+		if _, err = io.WriteString(w, `<li><input type="text" aria-label="Add tag" data-tag-entry></li></ul>`); err != nil {
+			return err
+		}
+		return tagInputScript.Render(ctx, w)
+	})
+}
+
+var tagInputScript = ComponentScript{
+	Name: `__templ_tagInput`,
+	Function: `(function(){` +
+		`function add(entry){var value=entry.value.replace(/,/g,"").trim();entry.value="";if(!value){return;}` +
+		`var list=entry.closest("[data-tag-input]");` +
+		`var exists=Array.prototype.some.call(list.querySelectorAll("input[type=hidden]"),function(input){return input.value===value;});if(exists){return;}` +
+		`var li=document.createElement("li");li.className="tag";` +
+		`var span=document.createElement("span");span.textContent=value;li.appendChild(span);` +
+		`var input=document.createElement("input");input.type="hidden";input.name=list.getAttribute("data-name");input.value=value;li.appendChild(input);` +
+		`var button=document.createElement("button");button.type="button";button.setAttribute("aria-label","Remove "+value);button.setAttribute("data-tag-remove","");button.textContent="×";li.appendChild(button);` +
+		`list.insertBefore(li,entry.closest("li"));}` +
+		`document.addEventListener("keydown",function(e){var entry=e.target;if(!entry.hasAttribute||!entry.hasAttribute("data-tag-entry")){return;}` +
+		`if(e.key==="Enter"||e.key===","){e.preventDefault();add(entry);return;}` +
+		`if(e.key==="Backspace"&&!entry.value){var tags=entry.closest("[data-tag-input]").querySelectorAll("li.tag");if(tags.length){tags[tags.length-1].remove();}}});` +
+		`document.addEventListener("click",function(e){var button=e.target.closest&&e.target.closest("[data-tag-remove]");if(button){button.closest("li").remove();}});` +
+		`})();`,
+}
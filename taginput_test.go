@@ -0,0 +1,48 @@
+package templ_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestTagInput(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []string
+		expected string
+	}{
+		{
+			name:     "without values",
+			expected: `<ul class="tag-input" data-tag-input data-name="tags"><li><input type="text" aria-label="Add tag" data-tag-entry></li></ul>`,
+		},
+		{
+			name:   "values are rendered as tags with hidden inputs",
+			values: []string{"go", "<html>"},
+			expected: `<ul class="tag-input" data-tag-input data-name="tags">` +
+				`<li class="tag"><span>go</span><input type="hidden" name="tags" value="go"><button type="button" aria-label="Remove go" data-tag-remove>×</button></li>` +
+				`<li class="tag"><span>&lt;html&gt;</span><input type="hidden" name="tags" value="&lt;html&gt;"><button type="button" aria-label="Remove &lt;html&gt;" data-tag-remove>×</button></li>` +
+				`<li><input type="text" aria-label="Add tag" data-tag-entry></li></ul>`,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			b := new(bytes.Buffer)
+			if err := templ.NewTagInput("tags", tt.values).Render(context.Background(), b); err != nil {
+				t.Fatalf("failed to render: %v", err)
+			}
+			html, _, ok := strings.Cut(b.String(), "<script")
+			if !ok {
+				t.Error("expected the tag input script to be rendered")
+			}
+			if diff := cmp.Diff(tt.expected, html); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package testutil
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/google/go-cmp/cmp"
+)
+
+// UpdateGoldenEnvVar is the environment variable that causes AssertGolden to rewrite
+// golden files when set to 1.
+const UpdateGoldenEnvVar = "TEMPL_UPDATE_GOLDEN"
+
+// AssertGolden renders the component, and compares the output to the content of the
+// golden file at path.
+//
+// If the golden file doesn't exist, or the TEMPL_UPDATE_GOLDEN environment variable
+// is set to 1, the output is written to the golden file, and the test passes.
+func AssertGolden(t *testing.T, c templ.Component, path string) {
+	t.Helper()
+	actual := TestRenderer{}.Render(t, c)
+
+	expected, err := os.ReadFile(path)
+	if os.Getenv(UpdateGoldenEnvVar) == "1" || errors.Is(err, fs.ErrNotExist) {
+		if err = os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("failed to create golden file directory: %v", err)
+		}
+		if err = os.WriteFile(path, []byte(actual), 0644); err != nil {
+			t.Fatalf("failed to write golden file: %v", err)
+		}
+		return
+	}
+	if err != nil {
+		t.Fatalf("failed to read golden file: %v", err)
+	}
+	if diff := cmp.Diff(string(expected), actual); diff != "" {
+		t.Errorf("output does not match golden file %s (-expected +actual), set %s=1 to update:\n%s", path, UpdateGoldenEnvVar, diff)
+	}
+}
@@ -0,0 +1,38 @@
+package testutil
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/a-h/templ"
+)
+
+func TestAssertGolden(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nested", "dir", "component.golden")
+
+	t.Run("the golden file is created if it doesn't exist", func(t *testing.T) {
+		AssertGolden(t, templ.Raw("<p>Hello</p>"), path)
+		actual, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatalf("failed to read golden file: %v", err)
+		}
+		if string(actual) != "<p>Hello</p>" {
+			t.Errorf("unexpected golden file content: %q", actual)
+		}
+	})
+	t.Run("subsequent runs compare the output to the golden file", func(t *testing.T) {
+		AssertGolden(t, templ.Raw("<p>Hello</p>"), path)
+	})
+	t.Run("the golden file is rewritten if the environment variable is set", func(t *testing.T) {
+		t.Setenv(UpdateGoldenEnvVar, "1")
+		AssertGolden(t, templ.Raw("<p>Goodbye</p>"), path)
+		actual, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatalf("failed to read golden file: %v", err)
+		}
+		if string(actual) != "<p>Goodbye</p>" {
+			t.Errorf("unexpected golden file content: %q", actual)
+		}
+	})
+}
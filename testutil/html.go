@@ -0,0 +1,127 @@
+package testutil
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// AssertHTMLEqual fails the test if the expected and actual HTML are not semantically equal.
+//
+// Attribute order, insignificant whitespace, self-closing syntax on void elements, and the
+// way that boolean attributes are written are ignored.
+func AssertHTMLEqual(t *testing.T, expected, actual string) {
+	t.Helper()
+	e, err := normalizeHTML(expected)
+	if err != nil {
+		t.Fatalf("failed to parse expected HTML: %v", err)
+	}
+	a, err := normalizeHTML(actual)
+	if err != nil {
+		t.Fatalf("failed to parse actual HTML: %v", err)
+	}
+	if diff := cmp.Diff(e, a); diff != "" {
+		t.Errorf("HTML is not equal (-expected +actual):\n%s", diff)
+	}
+}
+
+// HTMLEqual returns true if a and b are semantically equal HTML. See AssertHTMLEqual.
+func HTMLEqual(a, b string) bool {
+	na, err := normalizeHTML(a)
+	if err != nil {
+		return false
+	}
+	nb, err := normalizeHTML(b)
+	if err != nil {
+		return false
+	}
+	return na == nb
+}
+
+// normalizeHTML parses the HTML, and writes it out in a normalized form, one node per line.
+func normalizeHTML(s string) (string, error) {
+	nodes, err := parseHTML(s)
+	if err != nil {
+		return "", err
+	}
+	sb := new(strings.Builder)
+	for _, n := range nodes {
+		writeNormalizedNode(sb, n, 0, false)
+	}
+	return sb.String(), nil
+}
+
+// parseHTML parses complete documents, or fragments of HTML.
+func parseHTML(s string) (nodes []*html.Node, err error) {
+	trimmed := strings.ToLower(strings.TrimSpace(s))
+	if strings.HasPrefix(trimmed, "<!doctype") || strings.HasPrefix(trimmed, "<html") {
+		doc, err := html.Parse(strings.NewReader(s))
+		if err != nil {
+			return nil, err
+		}
+		for c := doc.FirstChild; c != nil; c = c.NextSibling {
+			nodes = append(nodes, c)
+		}
+		return nodes, nil
+	}
+	return html.ParseFragment(strings.NewReader(s), &html.Node{
+		Type:     html.ElementNode,
+		Data:     "body",
+		DataAtom: atom.Body,
+	})
+}
+
+// booleanAttributes can be written as name, name="", or name="name".
+var booleanAttributes = map[string]struct{}{
+	"allowfullscreen": {}, "async": {}, "autofocus": {}, "autoplay": {}, "checked": {},
+	"controls": {}, "default": {}, "defer": {}, "disabled": {}, "formnovalidate": {},
+	"hidden": {}, "inert": {}, "ismap": {}, "itemscope": {}, "loop": {}, "multiple": {},
+	"muted": {}, "nomodule": {}, "novalidate": {}, "open": {}, "playsinline": {},
+	"readonly": {}, "required": {}, "reversed": {}, "selected": {},
+}
+
+func writeNormalizedNode(sb *strings.Builder, n *html.Node, depth int, preformatted bool) {
+	indent := strings.Repeat("  ", depth)
+	switch n.Type {
+	case html.DoctypeNode:
+		fmt.Fprintf(sb, "%s<!DOCTYPE %s>\n", indent, strings.ToLower(n.Data))
+	case html.CommentNode:
+		fmt.Fprintf(sb, "%s<!--%s-->\n", indent, n.Data)
+	case html.TextNode:
+		text := n.Data
+		if !preformatted {
+			text = strings.Join(strings.Fields(text), " ")
+		}
+		if text != "" {
+			fmt.Fprintf(sb, "%s%q\n", indent, text)
+		}
+	case html.ElementNode:
+		attrs := make([]string, len(n.Attr))
+		for i, a := range n.Attr {
+			key := a.Key
+			if a.Namespace != "" {
+				key = a.Namespace + ":" + key
+			}
+			if _, isBoolean := booleanAttributes[key]; isBoolean && (a.Val == "" || strings.EqualFold(a.Val, key)) {
+				attrs[i] = key
+				continue
+			}
+			attrs[i] = fmt.Sprintf("%s=%q", key, a.Val)
+		}
+		sort.Strings(attrs)
+		sb.WriteString(indent + "<" + n.Data)
+		for _, a := range attrs {
+			sb.WriteString(" " + a)
+		}
+		sb.WriteString(">\n")
+		preformatted = preformatted || n.DataAtom == atom.Pre || n.DataAtom == atom.Textarea
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			writeNormalizedNode(sb, c, depth+1, preformatted)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package testutil
+
+import "testing"
+
+func TestHTMLEqual(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     string
+		expected bool
+	}{
+		{
+			name:     "identical HTML is equal",
+			a:        `<div class="a">Hello</div>`,
+			b:        `<div class="a">Hello</div>`,
+			expected: true,
+		},
+		{
+			name:     "attribute order is ignored",
+			a:        `<a href="/" class="link" id="home">Home</a>`,
+			b:        `<a id="home" class="link" href="/">Home</a>`,
+			expected: true,
+		},
+		{
+			name:     "insignificant whitespace is ignored",
+			a:        "<ul>\n\t<li>  One  item </li>\n</ul>",
+			b:        `<ul><li>One item</li></ul>`,
+			expected: true,
+		},
+		{
+			name:     "whitespace in pre elements is significant",
+			a:        "<pre>a\n  b</pre>",
+			b:        "<pre>a b</pre>",
+			expected: false,
+		},
+		{
+			name:     "void elements can be self-closing",
+			a:        `<br/><img src="a.png" /><input type="text">`,
+			b:        `<br><img src="a.png"><input type="text"/>`,
+			expected: true,
+		},
+		{
+			name:     "boolean attributes can be written in any form",
+			a:        `<input disabled required="" checked="checked">`,
+			b:        `<input checked disabled required>`,
+			expected: true,
+		},
+		{
+			name:     "attribute values are compared",
+			a:        `<div class="a"></div>`,
+			b:        `<div class="b"></div>`,
+			expected: false,
+		},
+		{
+			name:     "text is compared",
+			a:        `<p>Hello</p>`,
+			b:        `<p>Goodbye</p>`,
+			expected: false,
+		},
+		{
+			name:     "element names are compared",
+			a:        `<p>Hello</p>`,
+			b:        `<span>Hello</span>`,
+			expected: false,
+		},
+		{
+			name:     "documents can be compared",
+			a:        `<!DOCTYPE html><html><head><title>Test</title></head><body><p>Hi</p></body></html>`,
+			b:        "<!doctype html>\n<html>\n<head><title>Test</title></head>\n<body>\n<p>Hi</p>\n</body>\n</html>",
+			expected: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if actual := HTMLEqual(tt.a, tt.b); actual != tt.expected {
+				t.Errorf("expected %v, got %v", tt.expected, actual)
+			}
+			if tt.expected {
+				AssertHTMLEqual(t, tt.a, tt.b)
+			}
+		})
+	}
+}
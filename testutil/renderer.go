@@ -0,0 +1,63 @@
+// Package testutil provides helpers for testing templ components.
+package testutil
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/a-h/templ"
+)
+
+// TestRenderer renders components within tests.
+type TestRenderer struct {
+	// Context to render components with. If nil, context.Background() is used.
+	Context context.Context
+}
+
+func (r TestRenderer) context() context.Context {
+	ctx := r.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return templ.InitializeContext(ctx)
+}
+
+// Render renders the component, and returns the output. If rendering fails, the test is stopped.
+func (r TestRenderer) Render(t *testing.T, c templ.Component) string {
+	t.Helper()
+	sb := new(strings.Builder)
+	if err := c.Render(r.context(), sb); err != nil {
+		t.Fatalf("failed to render component: %v", err)
+	}
+	return sb.String()
+}
+
+// Contains fails the test if the component output does not contain substr.
+func (r TestRenderer) Contains(t *testing.T, c templ.Component, substr string) {
+	t.Helper()
+	if output := r.Render(t, c); !strings.Contains(output, substr) {
+		t.Errorf("expected output to contain %q, got:\n%s", substr, output)
+	}
+}
+
+// NotContains fails the test if the component output contains substr.
+func (r TestRenderer) NotContains(t *testing.T, c templ.Component, substr string) {
+	t.Helper()
+	if output := r.Render(t, c); strings.Contains(output, substr) {
+		t.Errorf("expected output not to contain %q, got:\n%s", substr, output)
+	}
+}
+
+// Matches fails the test if the component output does not match the regular expression pattern.
+func (r TestRenderer) Matches(t *testing.T, c templ.Component, pattern string) {
+	t.Helper()
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		t.Fatalf("invalid pattern %q: %v", pattern, err)
+	}
+	if output := r.Render(t, c); !re.MatchString(output) {
+		t.Errorf("expected output to match %q, got:\n%s", pattern, output)
+	}
+}
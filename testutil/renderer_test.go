@@ -0,0 +1,56 @@
+package testutil
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/a-h/templ"
+)
+
+type contextKey string
+
+func TestTestRenderer(t *testing.T) {
+	hello := templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+		name, _ := ctx.Value(contextKey("name")).(string)
+		if name == "" {
+			name = "World"
+		}
+		_, err := io.WriteString(w, "<p>Hello, "+name+"</p>")
+		return err
+	})
+
+	var r TestRenderer
+	t.Run("Render returns the output", func(t *testing.T) {
+		if output := r.Render(t, hello); output != "<p>Hello, World</p>" {
+			t.Errorf("unexpected output: %q", output)
+		}
+	})
+	t.Run("Contains checks for a substring", func(t *testing.T) {
+		r.Contains(t, hello, "Hello")
+	})
+	t.Run("NotContains checks for the absence of a substring", func(t *testing.T) {
+		r.NotContains(t, hello, "Goodbye")
+	})
+	t.Run("Matches checks for a regular expression", func(t *testing.T) {
+		r.Matches(t, hello, `^<p>Hello, \w+</p>$`)
+	})
+	t.Run("the context can be set", func(t *testing.T) {
+		r := TestRenderer{Context: context.WithValue(context.Background(), contextKey("name"), "templ")}
+		r.Contains(t, hello, "Hello, templ")
+	})
+	t.Run("the render context is initialized", func(t *testing.T) {
+		css := templ.ComponentCSSClass{ID: "c1", Class: ".c1{color:red}"}
+		c := templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+			for i := 0; i < 2; i++ {
+				if err := templ.RenderCSSItems(ctx, w, css); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if output := r.Render(t, c); output != `<style type="text/css">.c1{color:red}</style>` {
+			t.Errorf("expected CSS to be rendered once, got %q", output)
+		}
+	})
+}
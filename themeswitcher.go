@@ -0,0 +1,43 @@
+package templ
+
+import (
+	"context"
+	"io"
+	"strconv"
+)
+
+var themeSwitcherClass = newComponentCSSClass("themeSwitcher",
+	`display:inline-flex;align-items:center;gap:0.25em;padding:0.25em 0.75em;border:1px solid currentColor;border-radius:1em;background:transparent;color:inherit;cursor:pointer;`)
+
+// NewThemeSwitcher renders a button that toggles between the light and dark themes. The
+// theme is applied as the data-theme attribute of the html element, and the user's choice
+// is stored in localStorage. If the user hasn't chosen a theme, defaultTheme is used.
+func NewThemeSwitcher(defaultTheme string) Component {
+	return ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		if err = RenderCSSItems(ctx, w, themeSwitcherClass); err != nil {
+			return err
+		}
+		if err = writeStrings(w,
+			`<button type="button" class="theme-switcher `, themeSwitcherClass.ID,
+			`" aria-label="Dark mode" aria-pressed="`, strconv.FormatBool(defaultTheme == "dark"),
+			`" data-theme-switcher data-default-theme="`, EscapeString(defaultTheme), `">Dark mode</button>`); err != nil {
+			return err
+		}
+		return themeSwitcherScript.Render(ctx, w)
+	})
+}
+
+var themeSwitcherScript = ComponentScript{
+	Name: `__templ_themeSwitcher`,
+	Function: `(function(){` +
+		`var key="templ-theme";` +
+		`function stored(){try{return localStorage.getItem(key);}catch(e){return null;}}` +
+		`function apply(theme){document.documentElement.setAttribute("data-theme",theme);` +
+		`document.querySelectorAll("[data-theme-switcher]").forEach(function(b){b.setAttribute("aria-pressed",String(theme==="dark"));});}` +
+		`var button=document.querySelector("[data-theme-switcher]");` +
+		`apply(stored()||(button&&button.getAttribute("data-default-theme"))||"light");` +
+		`document.addEventListener("click",function(e){var b=e.target.closest&&e.target.closest("[data-theme-switcher]");if(!b){return;}` +
+		`var theme=b.getAttribute("aria-pressed")==="true"?"light":"dark";` +
+		`try{localStorage.setItem(key,theme);}catch(e){}apply(theme);});` +
+		`})();`,
+}
@@ -0,0 +1,54 @@
+package templ_test
+
+import (
+	"bytes"
+	"context"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/google/go-cmp/cmp"
+)
+
+var themeSwitcherClassPattern = regexp.MustCompile(`themeSwitcher_[0-9a-f]{4}`)
+
+func TestThemeSwitcher(t *testing.T) {
+	tests := []struct {
+		name         string
+		defaultTheme string
+		expected     string
+	}{
+		{
+			name:         "light",
+			defaultTheme: "light",
+			expected:     `<button type="button" class="theme-switcher themeSwitcher" aria-label="Dark mode" aria-pressed="false" data-theme-switcher data-default-theme="light">Dark mode</button>`,
+		},
+		{
+			name:         "dark",
+			defaultTheme: "dark",
+			expected:     `<button type="button" class="theme-switcher themeSwitcher" aria-label="Dark mode" aria-pressed="true" data-theme-switcher data-default-theme="dark">Dark mode</button>`,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			b := new(bytes.Buffer)
+			if err := templ.NewThemeSwitcher(tt.defaultTheme).Render(context.Background(), b); err != nil {
+				t.Fatalf("failed to render: %v", err)
+			}
+			output := themeSwitcherClassPattern.ReplaceAllString(b.String(), "themeSwitcher")
+			style, rest, ok := strings.Cut(output, "</style>")
+			if !ok || !strings.Contains(style, ".themeSwitcher{") {
+				t.Errorf("expected the CSS to be rendered, got %q", style)
+			}
+			html, script, _ := strings.Cut(rest, "<script")
+			if diff := cmp.Diff(tt.expected, html); diff != "" {
+				t.Error(diff)
+			}
+			if !strings.Contains(script, "localStorage") {
+				t.Errorf("expected the script to be rendered, got %q", script)
+			}
+		})
+	}
+}
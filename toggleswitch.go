@@ -0,0 +1,46 @@
+package templ
+
+import (
+	"context"
+	"io"
+	"strconv"
+)
+
+var toggleSwitchClass = newComponentCSSRules("toggleSwitch",
+	`&{display:inline-flex;align-items:center;gap:0.5em;cursor:pointer;}`+
+		`& input{position:absolute;opacity:0;width:1px;height:1px;}`+
+		`& .toggle-thumb{position:relative;display:inline-block;width:2.5em;height:1.4em;border-radius:0.7em;background-color:#ccc;transition:background-color 0.2s;}`+
+		`& .toggle-thumb::after{content:"";position:absolute;top:0.2em;left:0.2em;width:1em;height:1em;border-radius:50%;background-color:#fff;transition:transform 0.2s;}`+
+		`& input:checked+.toggle-thumb{background-color:#2563eb;}`+
+		`& input:checked+.toggle-thumb::after{transform:translateX(1.1em);}`+
+		`& input:focus-visible+.toggle-thumb{outline:2px solid #2563eb;outline-offset:2px;}`)
+
+// NewToggleSwitch renders an accessible on/off switch, using a checkbox with the switch role.
+func NewToggleSwitch(name, label string, checked bool) Component {
+	return ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		if err = RenderCSSItems(ctx, w, toggleSwitchClass); err != nil {
+			return err
+		}
+		if err = writeStrings(w,
+			`<label class="toggle `, toggleSwitchClass.ID, `">`,
+			`<input type="checkbox" name="`, EscapeString(name), `" role="switch" aria-checked="`, strconv.FormatBool(checked), `"`); err != nil {
+			return err
+		}
+		if checked {
+			if _, err = io.WriteString(w, ` checked`); err != nil {
+				return err
+			}
+		}
+		if err = writeStrings(w, `><span class="toggle-thumb"></span><span class="toggle-label">`, EscapeString(label), `</span></label>`); err != nil {
+			return err
+		}
+		return toggleSwitchScript.Render(ctx, w)
+	})
+}
+
+var toggleSwitchScript = ComponentScript{
+	Name: `__templ_toggleSwitch`,
+	Function: `document.addEventListener("change",function(e){` +
+		`var input=e.target;if(input.matches&&input.matches("input[role=switch]")){input.setAttribute("aria-checked",String(input.checked));}` +
+		`});`,
+}
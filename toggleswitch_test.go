@@ -0,0 +1,53 @@
+package templ_test
+
+import (
+	"bytes"
+	"context"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/google/go-cmp/cmp"
+)
+
+var toggleSwitchClassPattern = regexp.MustCompile(`toggleSwitch_[0-9a-f]{4}`)
+
+func TestToggleSwitch(t *testing.T) {
+	tests := []struct {
+		name     string
+		checked  bool
+		expected string
+	}{
+		{
+			name:    "unchecked",
+			checked: false,
+			expected: `<label class="toggle toggleSwitch_ID"><input type="checkbox" name="notify&amp;email" role="switch" aria-checked="false">` +
+				`<span class="toggle-thumb"></span><span class="toggle-label">Email &lt;notifications&gt;</span></label>`,
+		},
+		{
+			name:    "checked",
+			checked: true,
+			expected: `<label class="toggle toggleSwitch_ID"><input type="checkbox" name="notify&amp;email" role="switch" aria-checked="true" checked>` +
+				`<span class="toggle-thumb"></span><span class="toggle-label">Email &lt;notifications&gt;</span></label>`,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			b := new(bytes.Buffer)
+			if err := templ.NewToggleSwitch("notify&email", "Email <notifications>", tt.checked).Render(context.Background(), b); err != nil {
+				t.Fatalf("failed to render: %v", err)
+			}
+			style, html, _ := strings.Cut(b.String(), "</style>")
+			if !strings.Contains(style, "input:checked+.toggle-thumb") {
+				t.Errorf("expected the CSS to be rendered, got %q", style)
+			}
+			html, _, _ = strings.Cut(html, "<script")
+			html = toggleSwitchClassPattern.ReplaceAllString(html, "toggleSwitch_ID")
+			if diff := cmp.Diff(tt.expected, html); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
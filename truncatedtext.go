@@ -0,0 +1,37 @@
+package templ
+
+import (
+	"context"
+	"io"
+)
+
+// NewTruncatedText renders the first maxLength characters of the text, followed by an
+// ellipsis and a "Read more" button that shows the full text. Text that is no longer than
+// maxLength is rendered in full, without the button.
+func NewTruncatedText(text string, maxLength int) Component {
+	return ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		runes := []rune(text)
+		if maxLength < 0 || len(runes) <= maxLength {
+			return writeStrings(w, `<span class="truncated-text">`, EscapeString(text), `</span>`)
+		}
+		if err = writeStrings(w,
+			`<span class="truncated-text">`,
+			`<span data-truncated-preview>`, EscapeString(string(runes[:maxLength])), `…</span>`,
+			`<span aria-live="polite" data-truncated-full hidden>`, EscapeString(text), `</span> `,
+			`<button type="button" aria-expanded="false" data-truncated-toggle>Read more</button>`,
+			`</span>`); err != nil {
+			return err
+		}
+		return truncatedTextScript.Render(ctx, w)
+	})
+}
+
+var truncatedTextScript = ComponentScript{
+	Name: `__templ_truncatedText`,
+	Function: `document.addEventListener("click",function(e){` +
+		`var button=e.target.closest&&e.target.closest("[data-truncated-toggle]");if(!button){return;}` +
+		`var root=button.closest(".truncated-text");var expand=button.getAttribute("aria-expanded")!=="true";` +
+		`root.querySelector("[data-truncated-preview]").hidden=expand;root.querySelector("[data-truncated-full]").hidden=!expand;` +
+		`button.setAttribute("aria-expanded",String(expand));button.textContent=expand?"Read less":"Read more";` +
+		`});`,
+}
@@ -0,0 +1,55 @@
+package templ_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestTruncatedText(t *testing.T) {
+	tests := []struct {
+		name            string
+		text            string
+		maxLength       int
+		expected        string
+		expectedScripts int
+	}{
+		{
+			name:      "short text is not truncated",
+			text:      "Tom & Jerry",
+			maxLength: 11,
+			expected:  `<span class="truncated-text">Tom &amp; Jerry</span>`,
+		},
+		{
+			name:      "long text is truncated by character",
+			text:      "Café <crème> brûlée",
+			maxLength: 4,
+			expected: `<span class="truncated-text">` +
+				`<span data-truncated-preview>Café…</span>` +
+				`<span aria-live="polite" data-truncated-full hidden>Café &lt;crème&gt; brûlée</span> ` +
+				`<button type="button" aria-expanded="false" data-truncated-toggle>Read more</button>` +
+				`</span>`,
+			expectedScripts: 1,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			b := new(bytes.Buffer)
+			if err := templ.NewTruncatedText(tt.text, tt.maxLength).Render(context.Background(), b); err != nil {
+				t.Fatalf("failed to render: %v", err)
+			}
+			html, script, _ := strings.Cut(b.String(), "<script")
+			if diff := cmp.Diff(tt.expected, html); diff != "" {
+				t.Error(diff)
+			}
+			if count := strings.Count(b.String(), "<script"); count != tt.expectedScripts {
+				t.Errorf("expected %d scripts, got %d: %q", tt.expectedScripts, count, script)
+			}
+		})
+	}
+}
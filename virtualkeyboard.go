@@ -0,0 +1,86 @@
+package templ
+
+import (
+	"context"
+	"io"
+)
+
+// KeyboardLayout is the rows of keys of a virtual keyboard.
+//
+// Each key inserts its text into the target input, except for the special keys
+// "Backspace", which deletes the character before the cursor, "Space", which inserts
+// a space, and "Enter", which submits the target input's form.
+type KeyboardLayout [][]string
+
+// QWERTYLayout is a QWERTY keyboard layout.
+var QWERTYLayout = KeyboardLayout{
+	{"1", "2", "3", "4", "5", "6", "7", "8", "9", "0", "Backspace"},
+	{"q", "w", "e", "r", "t", "y", "u", "i", "o", "p"},
+	{"a", "s", "d", "f", "g", "h", "j", "k", "l", "Enter"},
+	{"z", "x", "c", "v", "b", "n", "m", ",", "."},
+	{"Space"},
+}
+
+var virtualKeyboardClass = newComponentCSSRules("virtualKeyboard",
+	`&{display:flex;flex-direction:column;gap:0.25em;user-select:none;}`+
+		`& .keyboard-row{display:flex;justify-content:center;gap:0.25em;}`+
+		`& button{min-width:2.5em;min-height:2.5em;border:1px solid #ccc;border-radius:0.25em;background-color:#f9fafb;font-size:1.25em;}`+
+		`& button:active{background-color:#e5e7eb;}`+
+		`& button[data-key=Space]{min-width:15em;}`)
+
+var virtualKeyboardLabels = map[string]string{
+	"Backspace": "⌫",
+	"Enter":     "⏎",
+	"Space":     " ",
+}
+
+// NewVirtualKeyboard renders an on-screen keyboard, which inserts key presses into the
+// input or textarea with the targetID.
+func NewVirtualKeyboard(targetID string, layout KeyboardLayout) Component {
+	return ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		if err = RenderCSSItems(ctx, w, virtualKeyboardClass); err != nil {
+			return err
+		}
+		if err = writeStrings(w,
+			`<div class="virtual-keyboard `, virtualKeyboardClass.ID, `" role="toolbar" aria-label="Keyboard" aria-controls="`, EscapeString(targetID), `" data-virtual-keyboard>`); err != nil {
+			return err
+		}
+		for _, row := range layout {
+			if _, err = io.WriteString(w, `<div class="keyboard-row">`); err != nil {
+				return err
+			}
+			for _, key := range row {
+				k := EscapeString(key)
+				text := k
+				if label, ok := virtualKeyboardLabels[key]; ok {
+					text = label
+				}
+				if err = writeStrings(w, `<button type="button" aria-label="`, k, `" data-key="`, k, `">`, text, `</button>`); err != nil {
+					return err
+				}
+			}
+			if _, err = io.WriteString(w, `</div>`); err != nil {
+				return err
+			}
+		}
+		if _, err = io.WriteString(w, `</div>`); err != nil {
+			return err
+		}
+		return virtualKeyboardScript.Render(ctx, w)
+	})
+}
+
+var virtualKeyboardScript = ComponentScript{
+	Name: `__templ_virtualKeyboard`,
+	Function: `(function(){` +
+		`document.addEventListener("mousedown",function(e){if(e.target.closest&&e.target.closest("[data-virtual-keyboard] button")){e.preventDefault();}});` +
+		`document.addEventListener("click",function(e){var button=e.target.closest&&e.target.closest("[data-virtual-keyboard] button");if(!button){return;}` +
+		`var input=document.getElementById(button.closest("[data-virtual-keyboard]").getAttribute("aria-controls"));if(!input){return;}` +
+		`var key=button.getAttribute("data-key");input.focus();` +
+		`if(key==="Enter"){if(input.form){input.form.requestSubmit();}return;}` +
+		`var start=input.selectionStart===null?input.value.length:input.selectionStart;var end=input.selectionEnd===null?start:input.selectionEnd;` +
+		`if(key==="Backspace"){if(start===end&&start>0){start--;}input.setRangeText("",start,end,"end");}` +
+		`else{input.setRangeText(key==="Space"?" ":key,start,end,"end");}` +
+		`input.dispatchEvent(new Event("input",{bubbles:true}));});` +
+		`})();`,
+}
@@ -0,0 +1,38 @@
+package templ_test
+
+import (
+	"bytes"
+	"context"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/google/go-cmp/cmp"
+)
+
+var virtualKeyboardClassPattern = regexp.MustCompile(`virtualKeyboard_[0-9a-f]{4}`)
+
+func TestVirtualKeyboard(t *testing.T) {
+	b := new(bytes.Buffer)
+	layout := templ.KeyboardLayout{{"a", "<"}, {"Backspace", "Space"}}
+	if err := templ.NewVirtualKeyboard("pin", layout).Render(context.Background(), b); err != nil {
+		t.Fatalf("failed to render: %v", err)
+	}
+	output := virtualKeyboardClassPattern.ReplaceAllString(b.String(), "virtualKeyboard")
+	style, rest, ok := strings.Cut(output, "</style>")
+	if !ok || !strings.Contains(style, ".virtualKeyboard button") {
+		t.Errorf("expected the CSS to be rendered, got %q", style)
+	}
+	html, _, ok := strings.Cut(rest, "<script")
+	if !ok {
+		t.Error("expected the virtual keyboard script to be rendered")
+	}
+	expected := `<div class="virtual-keyboard virtualKeyboard" role="toolbar" aria-label="Keyboard" aria-controls="pin" data-virtual-keyboard>` +
+		`<div class="keyboard-row"><button type="button" aria-label="a" data-key="a">a</button><button type="button" aria-label="&lt;" data-key="&lt;">&lt;</button></div>` +
+		`<div class="keyboard-row"><button type="button" aria-label="Backspace" data-key="Backspace">⌫</button><button type="button" aria-label="Space" data-key="Space"> </button></div>` +
+		`</div>`
+	if diff := cmp.Diff(expected, html); diff != "" {
+		t.Error(diff)
+	}
+}
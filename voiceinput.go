@@ -0,0 +1,52 @@
+package templ
+
+import (
+	"context"
+	"io"
+)
+
+// voiceInputClass hides the voice input buttons until the script has found that speech
+// recognition is supported. Using CSS, rather than the hidden attribute, means that buttons
+// rendered after the script, or added to the page later, are shown too.
+var voiceInputClass = newComponentCSSRules("voiceInput",
+	`html:not(.templ-speech-recognition) &{display:none;}`)
+
+// NewVoiceInput renders a labelled text input, with a button that transcribes speech into
+// the input using the Web Speech API. The button is hidden in browsers that don't support
+// speech recognition.
+func NewVoiceInput(name, label string) Component {
+	return ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		id := EscapeString(name)
+		if err = RenderCSSItems(ctx, w, voiceInputClass); err != nil {
+			return err
+		}
+		if err = writeStrings(w,
+			`<div class="voice-input">`,
+			`<label for="`, id, `">`, EscapeString(label), `</label>`,
+			`<input type="text" id="`, id, `" name="`, id, `">`,
+			`<button type="button" class="`, voiceInputClass.ID, `" aria-label="Start voice input" aria-pressed="false" aria-controls="`, id, `" data-voice-input>🎤</button>`,
+			`</div>`); err != nil {
+			return err
+		}
+		return voiceInputScript.Render(ctx, w)
+	})
+}
+
+var voiceInputScript = ComponentScript{
+	Name: `__templ_voiceInput`,
+	Function: `(function(){` +
+		`var Recognition=window.SpeechRecognition||window.webkitSpeechRecognition;if(!Recognition){return;}` +
+		`document.documentElement.classList.add("templ-speech-recognition");` +
+		`var active=null;` +
+		`function setPressed(button,pressed){button.setAttribute("aria-pressed",String(pressed));button.setAttribute("aria-label",pressed?"Stop voice input":"Start voice input");}` +
+		`document.addEventListener("click",function(e){var button=e.target.closest&&e.target.closest("[data-voice-input]");if(!button){return;}` +
+		`if(active){active.stop();return;}` +
+		`var input=document.getElementById(button.getAttribute("aria-controls"));` +
+		`var recognition=new Recognition();recognition.lang=document.documentElement.lang||navigator.language;recognition.interimResults=false;` +
+		`recognition.onresult=function(event){var text=event.results[0][0].transcript;input.value=input.value?input.value+" "+text:text;` +
+		`input.dispatchEvent(new Event("input",{bubbles:true}));};` +
+		`recognition.onend=function(){active=null;setPressed(button,false);};` +
+		`active=recognition;setPressed(button,true);recognition.start();` +
+		`});` +
+		`})();`,
+}
@@ -0,0 +1,54 @@
+package templ_test
+
+import (
+	"bytes"
+	"context"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/google/go-cmp/cmp"
+)
+
+var voiceInputClassPattern = regexp.MustCompile(`voiceInput_[0-9a-f]{4}`)
+
+func TestVoiceInput(t *testing.T) {
+	b := new(bytes.Buffer)
+	if err := templ.NewVoiceInput("search", "Search").Render(context.Background(), b); err != nil {
+		t.Fatalf("failed to render: %v", err)
+	}
+	output := voiceInputClassPattern.ReplaceAllString(b.String(), "voiceInput")
+	style, rest, ok := strings.Cut(output, "</style>")
+	if !ok || !strings.Contains(style, `html:not(.templ-speech-recognition) .voiceInput{display:none;}`) {
+		t.Errorf("expected the CSS to hide the button until speech recognition is supported, got %q", style)
+	}
+	html, script, ok := strings.Cut(rest, "<script")
+	if !ok || !strings.Contains(script, `classList.add("templ-speech-recognition")`) {
+		t.Errorf("expected the voice input script to be rendered, got %q", script)
+	}
+	expected := `<div class="voice-input"><label for="search">Search</label><input type="text" id="search" name="search">` +
+		`<button type="button" class="voiceInput" aria-label="Start voice input" aria-pressed="false" aria-controls="search" data-voice-input>🎤</button></div>`
+	if diff := cmp.Diff(expected, html); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestVoiceInputButtonsAreNotHidden(t *testing.T) {
+	b := new(bytes.Buffer)
+	ctx := templ.InitializeContext(context.Background())
+	for _, name := range []string{"a", "b"} {
+		if err := templ.NewVoiceInput(name, name).Render(ctx, b); err != nil {
+			t.Fatalf("failed to render: %v", err)
+		}
+	}
+	buttons := regexp.MustCompile(`<button [^>]*>`).FindAllString(b.String(), -1)
+	if len(buttons) != 2 {
+		t.Fatalf("expected 2 buttons, got %d", len(buttons))
+	}
+	for _, button := range buttons {
+		if strings.Contains(button, " hidden") {
+			t.Errorf("buttons should be hidden with CSS, so that the script doesn't need to find them, got %q", button)
+		}
+	}
+}